@@ -7,91 +7,91 @@ import (
 )
 
 func And[V bool](xGen Gen[bool], yGen Gen[bool]) Gen[bool] {
-	bin := Bin(func(xVal bool, yVal bool) bool {
+	bin := namedBin("And", func(xVal bool, yVal bool) bool {
 		return xVal && yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Or[V bool](xGen Gen[bool], yGen Gen[bool]) Gen[bool] {
-	bin := Bin(func(xVal bool, yVal bool) bool {
+	bin := namedBin("Or", func(xVal bool, yVal bool) bool {
 		return xVal || yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Eq[V comparable](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Eq", func(xVal V, yVal V) bool {
 		return xVal == yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Neq[V comparable](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Neq", func(xVal V, yVal V) bool {
 		return xVal != yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Gt[V constraints.Ordered](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Gt", func(xVal V, yVal V) bool {
 		return xVal > yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Lt[V constraints.Ordered](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Lt", func(xVal V, yVal V) bool {
 		return xVal < yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Ge[V constraints.Ordered](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Ge", func(xVal V, yVal V) bool {
 		return xVal >= yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Le[V constraints.Ordered](xGen Gen[V], yGen Gen[V]) Gen[bool] {
-	bin := Bin(func(xVal V, yVal V) bool {
+	bin := namedBin("Le", func(xVal V, yVal V) bool {
 		return xVal <= yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Add[V constraints.Ordered](xGen Gen[V], yGen Gen[V]) Gen[V] {
-	bin := Bin(func(xVal V, yVal V) V {
+	bin := namedBin("Add", func(xVal V, yVal V) V {
 		return xVal + yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Sub[V Number](xGen Gen[V], yGen Gen[V]) Gen[V] {
-	bin := Bin(func(xVal V, yVal V) V {
+	bin := namedBin("Sub", func(xVal V, yVal V) V {
 		return xVal - yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Mul[V Number](xGen Gen[V], yGen Gen[V]) Gen[V] {
-	bin := Bin(func(xVal V, yVal V) V {
+	bin := namedBin("Mul", func(xVal V, yVal V) V {
 		return xVal * yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Div[V Number](xGen Gen[V], yGen Gen[V]) Gen[V] {
-	bin := Bin(func(xVal V, yVal V) V {
+	bin := namedBin("Div", func(xVal V, yVal V) V {
 		return xVal / yVal
 	})
 	return bin(xGen, yGen)
 }
 
 func Mod[V constraints.Integer](xGen Gen[V], yGen Gen[V]) Gen[V] {
-	bin := Bin(func(xVal V, yVal V) V {
+	bin := namedBin("Mod", func(xVal V, yVal V) V {
 		return xVal % yVal
 	})
 	return bin(xGen, yGen)
@@ -99,8 +99,17 @@ func Mod[V constraints.Integer](xGen Gen[V], yGen Gen[V]) Gen[V] {
 
 // Bin returns a generator from two generators and a binary operation
 func Bin[V, W any](op func(V, V) W) func(Gen[V], Gen[V]) Gen[W] {
+	return namedBin("Bin", op)
+}
+
+// namedBin works as Bin but is traced as name.
+func namedBin[V, W any](name string, op func(V, V) W) func(Gen[V], Gen[V]) Gen[W] {
 	return func(xGen Gen[V], yGen Gen[V]) Gen[W] {
-		return func() iter.Seq[W] {
+		point := tracePoint()
+		// yGen is restarted for each value of xGen
+		yGen = tracedAt(point, name+".y", yGen)
+
+		return tracedAt(point, name, func() iter.Seq[W] {
 			return func(yield func(W) bool) {
 				xSeq := xGen()
 				for x := range xSeq {
@@ -113,6 +122,6 @@ func Bin[V, W any](op func(V, V) W) func(Gen[V], Gen[V]) Gen[W] {
 					}
 				}
 			}
-		}
+		})
 	}
 }
@@ -582,3 +582,10 @@ func TestMod(t *testing.T) {
 		})
 	}
 }
+
+func TestBinWithAlt(t *testing.T) {
+	gen := Add(Alt(Const(1), Const(2)), Alt(Const(10), Const(20)))
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []int{11, 21, 12, 22}, actual)
+}
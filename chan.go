@@ -0,0 +1,92 @@
+package itermania
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// FromChan creates a generator which iterates values received from ch until it is closed.
+//
+// NOTE: a channel cannot be replayed, so each invocation continues receiving from ch
+// where the previous one stopped. A value is not lost when the consumer stops early,
+// unless the consumer is Merge, which drops values received by its goroutines.
+func FromChan[V any](ch <-chan V) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range ch {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ToChan sends values iterated from gen to the returned channel on a new goroutine.
+//
+// The channel is closed when gen stops or ctx is done, so receivers that stop early
+// must cancel ctx to release the goroutine.
+func ToChan[V any](ctx context.Context, gen Gen[V], buffer int) <-chan V {
+	ch := make(chan V, max(buffer, 0))
+
+	go func() {
+		defer close(ch)
+
+		for v := range gen() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Merge returns a generator that iterates all of gens concurrently and yields values as soon as they are ready.
+//
+// Values of each generator keep their order, but values of different generators are interleaved.
+// When the consumer stops early, the iteration returns without waiting for the goroutines.
+// Each goroutine stops the next time its generator yields or when the generator finishes,
+// so a generator which never yields again, such as FromChan of a channel never closed, keeps its goroutine.
+//
+// NOTE: the value a goroutine has received from its generator when the consumer stops is dropped,
+// so a value of FromChan can be lost even though FromChan itself does not lose values
+func Merge[V any](gens ...Gen[V]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			done := make(chan struct{})
+			defer close(done)
+
+			ch := make(chan V)
+			var senderWg sync.WaitGroup
+			for _, gen := range gens {
+				senderWg.Add(1)
+				go func() {
+					defer senderWg.Done()
+
+					for v := range gen() {
+						select {
+						case ch <- v:
+						case <-done:
+							return
+						}
+					}
+				}()
+			}
+
+			go func() {
+				senderWg.Wait()
+				close(ch)
+			}()
+
+			for v := range ch {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
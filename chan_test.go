@@ -0,0 +1,149 @@
+package itermania
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Run("closed channel", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		actual := ToSlice(FromChan(ch))
+		assert.Equal(t, []int{1, 2, 3}, actual)
+	})
+
+	t.Run("continue receiving after early exit", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		gen := FromChan(ch)
+		assert.Equal(t, []int{1}, ToSlice(Head(gen, 1)))
+		assert.Equal(t, []int{2, 3}, ToSlice(gen))
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("finite generator", func(t *testing.T) {
+		assertNoGoroutineLeak(t, func() {
+			ch := ToChan(context.Background(), Range(0, 3, 1), 0)
+
+			actual := []int{}
+			for v := range ch {
+				actual = append(actual, v)
+			}
+			assert.Equal(t, []int{0, 1, 2}, actual)
+		})
+	})
+
+	t.Run("buffered", func(t *testing.T) {
+		assertNoGoroutineLeak(t, func() {
+			ch := ToChan(context.Background(), Range(0, 3, 1), 5)
+			assert.Equal(t, []int{0, 1, 2}, ToSlice(FromChan(ch)))
+		})
+	})
+
+	t.Run("canceled by receiver", func(t *testing.T) {
+		assertNoGoroutineLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			ch := ToChan(ctx, Inc(0), 1)
+
+			assert.Equal(t, 0, <-ch)
+			assert.Equal(t, 1, <-ch)
+			cancel()
+
+			// drain values sent before cancellation
+			for range ch {
+			}
+		})
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gen := FromChan(ToChan(ctx, Inc(0), 0))
+		assert.Equal(t, []int{0, 1, 2}, ToSlice(Head(gen, 3)))
+	})
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		gens     []Gen[int]
+		expected []int
+	}{
+		{
+			"no generators",
+			[]Gen[int]{},
+			[]int{},
+		},
+		{
+			"one generator",
+			[]Gen[int]{Range(0, 3, 1)},
+			[]int{0, 1, 2},
+		},
+		{
+			"multiple generators",
+			[]Gen[int]{Range(0, 3, 1), Range(10, 13, 1), FromSlice([]int{})},
+			[]int{0, 1, 2, 10, 11, 12},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertNoGoroutineLeak(t, func() {
+				actual := ToSlice(Merge(tt.gens...))
+				assert.ElementsMatch(t, tt.expected, actual)
+			})
+		})
+	}
+}
+
+func TestMergeKeepsOrderOfEachGenerator(t *testing.T) {
+	actual := ToSlice(Merge(Range(0, 100, 1), Range(100, 200, 1)))
+
+	first := []int{}
+	second := []int{}
+	for _, v := range actual {
+		if v < 100 {
+			first = append(first, v)
+		} else {
+			second = append(second, v)
+		}
+	}
+
+	assert.Equal(t, ToSlice(Range(0, 100, 1)), first)
+	assert.Equal(t, ToSlice(Range(100, 200, 1)), second)
+}
+
+func TestMergeEarlyExit(t *testing.T) {
+	assertNoGoroutineLeak(t, func() {
+		actual := ToSlice(Head(Merge(Inc(0), Loop(-1)), 10))
+		assert.Len(t, actual, 10)
+	})
+}
+
+func TestMergeEarlyExitWithIdleSource(t *testing.T) {
+	idle := make(chan int)
+
+	assertNoGoroutineLeak(t, func() {
+		assertReturns(t, time.Second, func() {
+			actual := ToSlice(Head(Merge(FromChan(idle), Inc(0)), 3))
+			assert.Equal(t, []int{0, 1, 2}, actual)
+		})
+
+		// the goroutine receiving from idle stops once idle is closed
+		close(idle)
+	})
+}
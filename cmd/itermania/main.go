@@ -0,0 +1,36 @@
+// Command itermania is a REPL to evaluate generator expressions written in package lang.
+//
+// Usage:
+//
+//	itermania [-n count]
+//
+// Enter an expression to print its first values. Commands are:
+//
+//	:more [count]  print next values of the last expression
+//	:type [expr]   print the type of values of expr (or the last expression)
+//	:time expr     evaluate expr and print the time taken
+//	:help          print this help
+//	:quit          exit
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	n := flag.Int("n", 10, "number of values printed at once")
+	flag.Parse()
+
+	if *n <= 0 {
+		fmt.Fprintln(os.Stderr, "-n must be positive")
+		os.Exit(2)
+	}
+
+	r := newREPL(os.Stdout, *n)
+	if err := r.run(os.Stdin); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
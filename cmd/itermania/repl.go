@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syuparn/itermania/lang"
+)
+
+const prompt = ">> "
+
+const help = `enter an expression to print its first values
+commands:
+  :more [count]  print next values of the last expression
+  :type [expr]   print the type of values of expr (or the last expression)
+  :time expr     evaluate expr and print the time taken
+  :help          print this help
+  :quit          exit
+`
+
+type repl struct {
+	out io.Writer
+	// number of values printed at once
+	n int
+
+	// live iterator of the last expression
+	next func() (any, bool)
+	stop func()
+	typ  lang.Type
+}
+
+func newREPL(out io.Writer, n int) *repl {
+	return &repl{out: out, n: n}
+}
+
+// run reads lines from in and evaluates them until EOF or :quit.
+func (r *repl) run(in io.Reader) error {
+	defer r.reset()
+
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(r.out, prompt)
+		if !scanner.Scan() {
+			fmt.Fprintln(r.out)
+			return scanner.Err()
+		}
+
+		if quit := r.eval(scanner.Text()); quit {
+			return nil
+		}
+	}
+}
+
+// eval evaluates a line and reports whether the REPL should quit.
+func (r *repl) eval(line string) bool {
+	line = strings.TrimSpace(line)
+	cmd, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch {
+	case line == "":
+	case cmd == ":quit" || cmd == ":q":
+		return true
+	case cmd == ":help":
+		fmt.Fprint(r.out, help)
+	case cmd == ":more":
+		r.more(arg)
+	case cmd == ":type":
+		r.printType(arg)
+	case cmd == ":time":
+		r.time(arg)
+	case strings.HasPrefix(cmd, ":"):
+		r.errorf("unknown command %s (see :help)", cmd)
+	default:
+		if r.start(line) {
+			r.print(r.n)
+		}
+	}
+	return false
+}
+
+// start compiles src and replaces the live iterator.
+func (r *repl) start(src string) bool {
+	node, err := lang.Parse(src)
+	if err != nil {
+		r.errorf("%s", err)
+		return false
+	}
+	typ, err := lang.Check(node)
+	if err != nil {
+		r.errorf("%s", err)
+		return false
+	}
+	gen, err := lang.CompileNode(node)
+	if err != nil {
+		r.errorf("%s", err)
+		return false
+	}
+
+	r.reset()
+	r.next, r.stop = iter.Pull(gen())
+	r.typ = typ
+	return true
+}
+
+// print prints at most n values pulled from the live iterator.
+func (r *repl) print(n int) {
+	defer func() {
+		// runtime errors such as division by zero
+		if err := recover(); err != nil {
+			r.reset()
+			r.errorf("%v", err)
+		}
+	}()
+
+	for range n {
+		v, ok := r.next()
+		if !ok {
+			fmt.Fprintln(r.out, "(end)")
+			r.reset()
+			return
+		}
+
+		if s, ok := v.(string); ok {
+			fmt.Fprintln(r.out, strconv.Quote(s))
+		} else {
+			fmt.Fprintln(r.out, v)
+		}
+	}
+}
+
+func (r *repl) more(arg string) {
+	n := r.n
+	if arg != "" {
+		var err error
+		n, err = strconv.Atoi(arg)
+		if err != nil || n <= 0 {
+			r.errorf("count must be a positive integer: %s", arg)
+			return
+		}
+	}
+
+	if r.next == nil {
+		r.errorf("no values left")
+		return
+	}
+	r.print(n)
+}
+
+func (r *repl) printType(src string) {
+	if src == "" {
+		if r.typ == 0 {
+			r.errorf("no expressions evaluated")
+			return
+		}
+		fmt.Fprintln(r.out, r.typ)
+		return
+	}
+
+	node, err := lang.Parse(src)
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	typ, err := lang.Check(node)
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	fmt.Fprintln(r.out, typ)
+}
+
+func (r *repl) time(src string) {
+	if src == "" {
+		r.errorf(":time requires an expression")
+		return
+	}
+
+	start := time.Now()
+	if !r.start(src) {
+		return
+	}
+	r.print(r.n)
+	fmt.Fprintf(r.out, "(%s)\n", time.Since(start))
+}
+
+// reset stops the live iterator.
+func (r *repl) reset() {
+	if r.stop != nil {
+		r.stop()
+	}
+	r.next = nil
+	r.stop = nil
+}
+
+func (r *repl) errorf(format string, args ...any) {
+	fmt.Fprintf(r.out, "error: "+format+"\n", args...)
+}
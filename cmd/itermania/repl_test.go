@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestREPL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			"finite expression",
+			"1 to 2\n",
+			">> 1\n2\n(end)\n>> \n",
+		},
+		{
+			"first n values",
+			"1 to 10\n",
+			">> 1\n2\n3\n>> \n",
+		},
+		{
+			"strings",
+			`"a" | "b"` + "\n",
+			">> \"a\"\n\"b\"\n(end)\n>> \n",
+		},
+		{
+			"more",
+			"1 to 10\n:more\n:more 1\n",
+			">> 1\n2\n3\n>> 4\n5\n6\n>> 7\n>> \n",
+		},
+		{
+			"more until end",
+			"1 to 4\n:more\n:more\n",
+			">> 1\n2\n3\n>> 4\n(end)\n>> error: no values left\n>> \n",
+		},
+		{
+			"invalid more",
+			"1 to 10\n:more x\n",
+			">> 1\n2\n3\n>> error: count must be a positive integer: x\n>> \n",
+		},
+		{
+			"type of the last expression",
+			"1 < 2\n:type\n",
+			">> true\n(end)\n>> bool\n>> \n",
+		},
+		{
+			"type of an expression",
+			`:type "a" | "b"` + "\n",
+			">> string\n>> \n",
+		},
+		{
+			"type before evaluation",
+			":type\n",
+			">> error: no expressions evaluated\n>> \n",
+		},
+		{
+			"syntax error",
+			"1 +\n",
+			">> error: 1:4: unexpected end of input\n>> \n",
+		},
+		{
+			"type error",
+			"1 + true\n",
+			">> error: 1:3: mismatched types int + bool\n>> \n",
+		},
+		{
+			"runtime error",
+			"6 / (2 | 0)\n:more\n",
+			">> 3\nerror: runtime error: integer divide by zero\n>> error: no values left\n>> \n",
+		},
+		{
+			"unknown command",
+			":foo\n",
+			">> error: unknown command :foo (see :help)\n>> \n",
+		},
+		{
+			"quit",
+			":quit\n1\n",
+			">> ",
+		},
+		{
+			"empty line",
+			"\n",
+			">> >> \n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			r := newREPL(out, 3)
+
+			err := r.run(strings.NewReader(tt.input))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestREPLTime(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := newREPL(out, 3)
+
+	err := r.run(strings.NewReader(":time 1 to 10\n:more 1\n"))
+	assert.NoError(t, err)
+	assert.Regexp(t, `^>> 1\n2\n3\n\(.+s\)\n>> 4\n>> \n$`, out.String())
+}
+
+func TestREPLInfinite(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := newREPL(out, 2)
+
+	err := r.run(strings.NewReader("every n := 1 to 1000000000 do n * n\n:more\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, ">> 1\n4\n>> 9\n16\n>> \n", out.String())
+}
@@ -0,0 +1,69 @@
+package itermania
+
+import (
+	"context"
+	"iter"
+)
+
+// WithContext returns a generator that stops iterating gen when ctx is done.
+//
+// NOTE: ctx is checked only between values, so a generator blocking to produce a value is not interrupted
+func WithContext[V any](ctx context.Context, gen Gen[V]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			if ctx.Err() != nil {
+				return
+			}
+
+			for v := range gen() {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// HeadContext works as Head but stops when ctx is done.
+//
+// NOTE: as WithContext, ctx is checked only when gen yields
+func HeadContext[V any](ctx context.Context, gen Gen[V], n int) Gen[V] {
+	return Head(WithContext(ctx, gen), n)
+}
+
+// BindContext works as Bind but stops when ctx is done, even while the inner generator is iterated.
+func BindContext[V, W any](ctx context.Context, gen Gen[V], f func(V) Gen[W]) Gen[W] {
+	return Bind(WithContext(ctx, gen), func(v V) Gen[W] {
+		return WithContext(ctx, f(v))
+	})
+}
+
+// WhereContext works as Where but stops when ctx is done.
+//
+// ctx is checked for every value of gen and condGen, including values skipped by false conditions,
+// so it stops even if no values meet the condition.
+// NOTE: as WithContext, a generator blocking to produce a value is not interrupted
+func WhereContext[V any](ctx context.Context, gen Gen[V], condGen Gen[bool]) Gen[V] {
+	return Where(WithContext(ctx, gen), WithContext(ctx, condGen))
+}
+
+// ToSliceContext produces a slice iterated from gen until ctx is done.
+//
+// If ctx is done, it returns values iterated so far and ctx.Err().
+// NOTE: as WithContext, ctx is checked only when gen yields. A filtering generator such as Where which rarely yields
+// runs past the deadline, and one which never yields never returns.
+// Check ctx inside such generators by WhereContext, BindContext or WithContext of their sources.
+func ToSliceContext[V any](ctx context.Context, gen Gen[V]) ([]V, error) {
+	seq := WithContext(ctx, gen)()
+	values := []V{}
+
+	for v := range seq {
+		values = append(values, v)
+	}
+
+	return values, ctx.Err()
+}
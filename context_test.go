@@ -0,0 +1,148 @@
+package itermania
+
+import (
+	"context"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cancelAfter returns a generator that cancels ctx after n values of gen are iterated.
+func cancelAfter[V any](gen Gen[V], n int, cancel context.CancelFunc) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			i := 0
+			for v := range gen() {
+				if i == n {
+					cancel()
+				}
+				i++
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		gen := WithContext(context.Background(), Range(0, 3, 1))
+		assert.Equal(t, []int{0, 1, 2}, ToSlice(gen))
+	})
+
+	t.Run("already canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		gen := WithContext(ctx, Inc(0))
+		assert.Equal(t, []int{}, ToSlice(gen))
+	})
+
+	t.Run("canceled while iterating", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gen := WithContext(ctx, cancelAfter(Inc(0), 3, cancel))
+		assert.Equal(t, []int{0, 1, 2}, ToSlice(gen))
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		for range WithContext(ctx, Inc(0))() {
+		}
+		assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+}
+
+func TestHeadContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		gen := HeadContext(context.Background(), Inc(0), 3)
+		assert.Equal(t, []int{0, 1, 2}, ToSlice(gen))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gen := HeadContext(ctx, cancelAfter(Inc(0), 2, cancel), 5)
+		assert.Equal(t, []int{0, 1}, ToSlice(gen))
+	})
+}
+
+func TestBindContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		gen := BindContext(context.Background(), Range(1, 3, 1), func(i int) Gen[int] {
+			return Range(0, i, 1)
+		})
+		assert.Equal(t, []int{0, 0, 1}, ToSlice(gen))
+	})
+
+	t.Run("canceled in inner generator", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gen := BindContext(ctx, Inc(2), func(i int) Gen[int] {
+			return cancelAfter(Inc(i*10), 2, cancel)
+		})
+		assert.Equal(t, []int{20, 21}, ToSlice(gen))
+	})
+}
+
+func TestWhereContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		gen := WhereContext(context.Background(), Range(1, 5, 1), FromSlice([]bool{true, false, true, false}))
+		assert.Equal(t, []int{1, 3}, ToSlice(gen))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gen := WhereContext(ctx, cancelAfter(Inc(0), 4, cancel), Loop(true))
+		assert.Equal(t, []int{0, 1, 2, 3}, ToSlice(gen))
+	})
+}
+
+func TestToSliceContextWithDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assertReturns(t, time.Second, func() {
+		// no values meet the condition, but ctx is checked for each skipped value
+		actual, err := ToSliceContext(ctx, WhereContext(ctx, Inc(0), Loop(false)))
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, []int{}, actual)
+	})
+}
+
+func TestToSliceContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		actual, err := ToSliceContext(context.Background(), Range(0, 3, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2}, actual)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		actual, err := ToSliceContext(ctx, cancelAfter(Inc(0), 3, cancel))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int{0, 1, 2}, actual)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := ToSliceContext(ctx, Inc(0))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
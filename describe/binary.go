@@ -0,0 +1,72 @@
+package describe
+
+import (
+	"github.com/syuparn/itermania"
+	"golang.org/x/exp/constraints"
+)
+
+func And(x Expr[bool], y Expr[bool]) Expr[bool] {
+	return binary("And", itermania.And[bool], x, y)
+}
+
+func Or(x Expr[bool], y Expr[bool]) Expr[bool] {
+	return binary("Or", itermania.Or[bool], x, y)
+}
+
+func Eq[V comparable](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Eq", itermania.Eq[V], x, y)
+}
+
+func Neq[V comparable](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Neq", itermania.Neq[V], x, y)
+}
+
+func Gt[V constraints.Ordered](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Gt", itermania.Gt[V], x, y)
+}
+
+func Lt[V constraints.Ordered](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Lt", itermania.Lt[V], x, y)
+}
+
+func Ge[V constraints.Ordered](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Ge", itermania.Ge[V], x, y)
+}
+
+func Le[V constraints.Ordered](x Expr[V], y Expr[V]) Expr[bool] {
+	return binary("Le", itermania.Le[V], x, y)
+}
+
+func Add[V constraints.Ordered](x Expr[V], y Expr[V]) Expr[V] {
+	return binary("Add", itermania.Add[V], x, y)
+}
+
+func Sub[V itermania.Number](x Expr[V], y Expr[V]) Expr[V] {
+	return binary("Sub", itermania.Sub[V], x, y)
+}
+
+func Mul[V itermania.Number](x Expr[V], y Expr[V]) Expr[V] {
+	return binary("Mul", itermania.Mul[V], x, y)
+}
+
+func Div[V itermania.Number](x Expr[V], y Expr[V]) Expr[V] {
+	return binary("Div", itermania.Div[V], x, y)
+}
+
+func Mod[V constraints.Integer](x Expr[V], y Expr[V]) Expr[V] {
+	return binary("Mod", itermania.Mod[V], x, y)
+}
+
+// Bin works as itermania.Bin with the name of op.
+func Bin[V, W any](name string, op func(V, V) W) func(Expr[V], Expr[V]) Expr[W] {
+	return func(x Expr[V], y Expr[V]) Expr[W] {
+		return binary(name, itermania.Bin(op), x, y)
+	}
+}
+
+func binary[V, W any](name string, op func(itermania.Gen[V], itermania.Gen[V]) itermania.Gen[W], x Expr[V], y Expr[V]) Expr[W] {
+	return Expr[W]{
+		Gen:  op(x.Gen, y.Gen),
+		Node: newNode(name, nil, x.Node, y.Node),
+	}
+}
@@ -0,0 +1,113 @@
+package describe
+
+import (
+	"github.com/syuparn/itermania"
+	"golang.org/x/exp/constraints"
+)
+
+func Const[V any](v V) Expr[V] {
+	return Of("Const", itermania.Const(v), v)
+}
+
+func Head[V any](e Expr[V], n int) Expr[V] {
+	return Expr[V]{
+		Gen:  itermania.Head(e.Gen, n),
+		Node: newNode("Head", []any{n}, e.Node),
+	}
+}
+
+func Inc[V constraints.Integer](v V) Expr[V] {
+	return Of("Inc", itermania.Inc(v), v)
+}
+
+func Dec[V constraints.Integer](v V) Expr[V] {
+	return Of("Dec", itermania.Dec(v), v)
+}
+
+func Range[V constraints.Integer](start, stop, step V) Expr[V] {
+	return Of("Range", itermania.Range(start, stop, step), start, stop, step)
+}
+
+func FromSlice[V any](values []V) Expr[V] {
+	return Of("FromSlice", itermania.FromSlice(values), values)
+}
+
+func Loop[V any](v V) Expr[V] {
+	return Of("Loop", itermania.Loop(v), v)
+}
+
+func Concat[V any](es ...Expr[V]) Expr[V] {
+	return variadic("Concat", itermania.Concat[V], es)
+}
+
+func Alt[V any](es ...Expr[V]) Expr[V] {
+	return variadic("Alt", itermania.Alt[V], es)
+}
+
+func Where[V any](e Expr[V], cond Expr[bool]) Expr[V] {
+	return Expr[V]{
+		Gen:  itermania.Where(e.Gen, cond.Gen),
+		Node: newNode("Where", nil, e.Node, cond.Node),
+	}
+}
+
+// Bind works as itermania.Bind.
+//
+// Since the result of f is unknown until the iteration, f is described as a node named "func".
+func Bind[V, W any](e Expr[V], f func(V) Expr[W]) Expr[W] {
+	return Expr[W]{
+		Gen: itermania.Bind(e.Gen, func(v V) itermania.Gen[W] {
+			return f(v).Gen
+		}),
+		Node: newNode("Bind", nil, e.Node, funcNode),
+	}
+}
+
+func If[V any](cond Expr[bool], then Expr[V], els Expr[V]) Expr[V] {
+	return Expr[V]{
+		Gen:  itermania.If(cond.Gen, then.Gen, els.Gen),
+		Node: newNode("If", nil, cond.Node, then.Node, els.Node),
+	}
+}
+
+func All(e Expr[bool]) Expr[bool] {
+	return Expr[bool]{
+		Gen:  itermania.All(e.Gen),
+		Node: newNode("All", nil, e.Node),
+	}
+}
+
+func Any(e Expr[bool]) Expr[bool] {
+	return Expr[bool]{
+		Gen:  itermania.Any(e.Gen),
+		Node: newNode("Any", nil, e.Node),
+	}
+}
+
+func Repeat[V any](e Expr[V]) Expr[V] {
+	return Expr[V]{
+		Gen:  itermania.Repeat(e.Gen),
+		Node: newNode("Repeat", nil, e.Node),
+	}
+}
+
+func Limit[V any](e Expr[V], n Expr[int]) Expr[V] {
+	return Expr[V]{
+		Gen:  itermania.Limit(e.Gen, n.Gen),
+		Node: newNode("Limit", nil, e.Node, n.Node),
+	}
+}
+
+func variadic[V any](name string, f func(...itermania.Gen[V]) itermania.Gen[V], es []Expr[V]) Expr[V] {
+	gens := make([]itermania.Gen[V], len(es))
+	nodes := make([]*Node, len(es))
+	for i, e := range es {
+		gens[i] = e.Gen
+		nodes[i] = e.Node
+	}
+
+	return Expr[V]{
+		Gen:  f(gens...),
+		Node: newNode(name, nil, nodes...),
+	}
+}
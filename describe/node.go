@@ -0,0 +1,136 @@
+// Package describe provides generators which record their expression trees.
+//
+// A generator in itermania is an opaque function.
+// Build a pipeline with the functions in this package instead of those in itermania
+// to see its structure by Describe or to compare two pipelines by Equal.
+package describe
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/syuparn/itermania"
+)
+
+// Node is a node of an expression tree.
+type Node struct {
+	// Name is the name of the combinator.
+	Name string
+	// Args are the other arguments than generators.
+	Args []string
+	// Children are the generators passed to the combinator.
+	Children []*Node
+}
+
+// Expr is a generator with its expression tree.
+type Expr[V any] struct {
+	Gen  itermania.Gen[V]
+	Node *Node
+}
+
+// Format is a format to render an expression tree.
+type Format int
+
+const (
+	// Text renders a tree as indented text.
+	Text Format = iota
+	// DOT renders a tree as a Graphviz DOT graph.
+	DOT
+)
+
+// Of wraps gen as a leaf named name.
+func Of[V any](name string, gen itermania.Gen[V], args ...any) Expr[V] {
+	return Expr[V]{Gen: gen, Node: newNode(name, args)}
+}
+
+// Describe renders the expression tree of e in format.
+func Describe[V any](e Expr[V], format Format) string {
+	if format == DOT {
+		return e.Node.DOT()
+	}
+	return e.Node.String()
+}
+
+// Equal reports whether e and f have the same expression trees.
+func Equal[V any](e, f Expr[V]) bool {
+	return e.Node.Equal(f.Node)
+}
+
+// Label returns the name of the node with its arguments.
+func (n *Node) Label() string {
+	if len(n.Args) == 0 {
+		return n.Name
+	}
+	return fmt.Sprintf("%s(%s)", n.Name, strings.Join(n.Args, ", "))
+}
+
+// String renders the tree as indented text.
+func (n *Node) String() string {
+	var sb strings.Builder
+	n.writeText(&sb, 0)
+	return sb.String()
+}
+
+func (n *Node) writeText(sb *strings.Builder, depth int) {
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString(n.Label())
+	sb.WriteString("\n")
+
+	for _, child := range n.Children {
+		child.writeText(sb, depth+1)
+	}
+}
+
+// DOT renders the tree as a Graphviz DOT graph.
+func (n *Node) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+
+	id := 0
+	var write func(n *Node) int
+	write = func(n *Node) int {
+		self := id
+		id++
+		fmt.Fprintf(&sb, "  n%d [label=%q];\n", self, n.Label())
+
+		for _, child := range n.Children {
+			c := write(child)
+			fmt.Fprintf(&sb, "  n%d -> n%d;\n", self, c)
+		}
+		return self
+	}
+	write(n)
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Equal reports whether n and m are structurally equal.
+func (n *Node) Equal(m *Node) bool {
+	if n == nil || m == nil {
+		return n == m
+	}
+
+	if n.Name != m.Name || !slices.Equal(n.Args, m.Args) || len(n.Children) != len(m.Children) {
+		return false
+	}
+
+	for i := range n.Children {
+		if !n.Children[i].Equal(m.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func newNode(name string, args []any, children ...*Node) *Node {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = fmt.Sprintf("%#v", arg)
+	}
+	return &Node{Name: name, Args: strs, Children: children}
+}
+
+// funcNode is a placeholder of a function argument, whose result is unknown until the iteration.
+var funcNode = &Node{Name: "func"}
@@ -0,0 +1,150 @@
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+func isPrime(n int) Expr[int] {
+	return Where(Const(n), All(Not(Eq(Mod(Const(n), Range(2, n, 1)), Const(0)))))
+}
+
+func TestDescribeText(t *testing.T) {
+	expected := `Where
+  Const(5)
+  All
+    Not
+      Eq
+        Mod
+          Const(5)
+          Range(2, 5, 1)
+        Const(0)
+`
+
+	assert.Equal(t, expected, Describe(isPrime(5), Text))
+}
+
+func TestDescribeDOT(t *testing.T) {
+	e := Head(Bind(Inc(2), isPrime), 10)
+	expected := `digraph {
+  n0 [label="Head(10)"];
+  n1 [label="Bind"];
+  n2 [label="Inc(2)"];
+  n1 -> n2;
+  n3 [label="func"];
+  n1 -> n3;
+  n0 -> n1;
+}
+`
+
+	assert.Equal(t, expected, Describe(e, DOT))
+}
+
+func TestDescribeLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		node     *Node
+		expected string
+	}{
+		{"string", Const("foo").Node, `Const("foo")`},
+		{"slice", FromSlice([]int{1, 2}).Node, `FromSlice([]int{1, 2})`},
+		{"no args", Not(Const(true)).Node, "Not"},
+		{"custom binary", Bin("Pow", func(x, y int) int { return x ^ y })(Const(1), Const(2)).Node, "Pow"},
+		{"custom unary", Uni("Neg", func(x int) int { return -x })(Const(1)).Node, "Neg"},
+		{"leaf", Of("Primes", itermania.Inc(2), "trial division").Node, `Primes("trial division")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.node.Label())
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        Expr[int]
+		y        Expr[int]
+		expected bool
+	}{
+		{
+			"same pipelines",
+			isPrime(5),
+			isPrime(5),
+			true,
+		},
+		{
+			"different arguments",
+			isPrime(5),
+			isPrime(7),
+			false,
+		},
+		{
+			"different combinators",
+			Add(Const(1), Const(2)),
+			Sub(Const(1), Const(2)),
+			false,
+		},
+		{
+			"different children",
+			Concat(Const(1), Const(2)),
+			Concat(Const(1)),
+			false,
+		},
+		{
+			"funcs are not compared",
+			Bind(Inc(0), func(i int) Expr[int] { return Const(i) }),
+			Bind(Inc(0), func(i int) Expr[int] { return Const(i * 2) }),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Equal(tt.x, tt.y))
+		})
+	}
+}
+
+func TestNodeEqualNil(t *testing.T) {
+	var n *Node
+
+	assert.True(t, n.Equal(nil))
+	assert.False(t, n.Equal(Const(1).Node))
+	assert.False(t, Const(1).Node.Equal(nil))
+}
+
+func TestGen(t *testing.T) {
+	tests := []struct {
+		name     string
+		e        Expr[int]
+		expected itermania.Gen[int]
+	}{
+		{
+			"primes",
+			Head(Bind(Inc(2), isPrime), 10),
+			itermania.Head(itermania.Bind(itermania.Inc(2), func(n int) itermania.Gen[int] {
+				return itermania.Where(itermania.Const(n), itermania.All(itermania.Not(itermania.Eq(itermania.Mod(itermania.Const(n), itermania.Range(2, n, 1)), itermania.Const(0)))))
+			}), 10),
+		},
+		{
+			"if",
+			If(Lt(Range(0, 4, 1), Const(2)), Inc(0), Dec(0)),
+			itermania.If(itermania.Lt(itermania.Range(0, 4, 1), itermania.Const(2)), itermania.Inc(0), itermania.Dec(0)),
+		},
+		{
+			"limit",
+			Limit(Repeat(Alt(Const(1), Const(2))), FromSlice([]int{3})),
+			itermania.Limit(itermania.Repeat(itermania.Alt(itermania.Const(1), itermania.Const(2))), itermania.FromSlice([]int{3})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, itermania.ToSlice(tt.expected), itermania.ToSlice(tt.e.Gen))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package describe
+
+import "github.com/syuparn/itermania"
+
+func Not(x Expr[bool]) Expr[bool] {
+	return unary("Not", itermania.Not, x)
+}
+
+// Uni works as itermania.Uni with the name of op.
+func Uni[V, W any](name string, op func(V) W) func(Expr[V]) Expr[W] {
+	return func(x Expr[V]) Expr[W] {
+		return unary(name, itermania.Uni(op), x)
+	}
+}
+
+func unary[V, W any](name string, op func(itermania.Gen[V]) itermania.Gen[W], x Expr[V]) Expr[W] {
+	return Expr[W]{
+		Gen:  op(x.Gen),
+		Node: newNode(name, nil, x.Node),
+	}
+}
@@ -0,0 +1,350 @@
+// Package csv streams CSV rows from and into generators.
+//
+// The first row of the input is the header. Each following row is decoded into
+// a struct whose fields are matched to columns by csv tags, or into a map keyed by column names.
+// Rows are decoded one by one while the generator is iterated,
+// so the whole input is never buffered the way itermania.ToSlice does.
+package csv
+
+import (
+	"encoding"
+	stdcsv "encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/syuparn/itermania"
+)
+
+// ErrUnsupportedType is reported when T cannot be converted from or into a CSV row.
+var ErrUnsupportedType = errors.New("csv: unsupported type")
+
+// Records returns an error-aware generator that iterates raw rows of the reader, including the header.
+//
+// The reader is opened on every invocation and closed when the iteration finishes, fails or is stopped early.
+func Records(open itermania.Opener) itermania.GenE[[]string] {
+	return func() iter.Seq2[[]string, error] {
+		return func(yield func([]string, error) bool) {
+			rc, err := open()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			defer rc.Close()
+
+			r := stdcsv.NewReader(rc)
+			for {
+				record, err := r.Read()
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+
+				if !yield(record, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Decode returns an error-aware generator that iterates rows of the reader decoded into T.
+//
+// T must be a struct, map[string]string or map[string]any.
+// Struct fields are matched to columns by the csv tag or the field name, and the tag "-" ignores the field.
+// Columns without matching fields are ignored.
+// Fields must be strings, booleans, numbers or implement encoding.TextUnmarshaler.
+// Map values are always strings.
+// The reader is opened on every invocation and closed when the iteration finishes, fails or is stopped early.
+func Decode[T any](open itermania.Opener) itermania.GenE[T] {
+	return func() iter.Seq2[T, error] {
+		return func(yield func(T, error) bool) {
+			var zero T
+
+			var header []string
+			var set func(*T, int, string) error
+			// number of records following the header
+			n := 0
+			for record, err := range Records(open)() {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				if header == nil {
+					header = record
+					set, err = setter[T](header)
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+					continue
+				}
+
+				n++
+				v, err := decodeRecord(record, set)
+				if err != nil {
+					yield(zero, fmt.Errorf("csv: record %d: %w", n, err))
+					return
+				}
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func decodeRecord[T any](record []string, set func(*T, int, string) error) (T, error) {
+	var v T
+	if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.Map {
+		rv.Set(reflect.MakeMapWithSize(rv.Type(), len(record)))
+	}
+
+	for i, s := range record {
+		if err := set(&v, i, s); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// setter returns a function which sets the i-th column into T.
+func setter[T any](header []string) (func(*T, int, string) error, error) {
+	typ := reflect.TypeFor[T]()
+
+	switch {
+	case isStringMap(typ):
+		return func(v *T, i int, s string) error {
+			reflect.ValueOf(v).Elem().SetMapIndex(reflect.ValueOf(header[i]).Convert(typ.Key()), reflect.ValueOf(s).Convert(typ.Elem()))
+			return nil
+		}, nil
+	case typ.Kind() == reflect.Struct:
+		fields := structFields(typ)
+		indices := make([][]int, len(header))
+		for i, name := range header {
+			if f, ok := fields[name]; ok {
+				indices[i] = f.Index
+			}
+		}
+
+		return func(v *T, i int, s string) error {
+			if i >= len(indices) || indices[i] == nil {
+				return nil
+			}
+
+			if err := parse(reflect.ValueOf(v).Elem().FieldByIndex(indices[i]), s); err != nil {
+				return fmt.Errorf("column %q: %w", header[i], err)
+			}
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, typ)
+	}
+}
+
+func isStringMap(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Map || typ.Key().Kind() != reflect.String {
+		return false
+	}
+	return typ.Elem().Kind() == reflect.String ||
+		(typ.Elem().Kind() == reflect.Interface && typ.Elem().NumMethod() == 0)
+}
+
+// structFields returns exported fields of typ keyed by their column names.
+func structFields(typ reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for _, f := range reflect.VisibleFields(typ) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+
+		name := f.Tag.Get("csv")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+// columns returns names and fields of typ in declaration order.
+func columns(typ reflect.Type) ([]string, []reflect.StructField) {
+	fields := structFields(typ)
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return slices.Compare(fields[a].Index, fields[b].Index)
+	})
+
+	sorted := make([]reflect.StructField, len(names))
+	for i, name := range names {
+		sorted[i] = fields[name]
+	}
+	return names, sorted
+}
+
+func parse(v reflect.Value, s string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+	}
+	return nil
+}
+
+func format(v reflect.Value) (string, error) {
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+	}
+}
+
+// Encode writes all values of gen into w as CSV rows following a header.
+//
+// T must be a struct, map[string]string or map[string]any.
+// The header of a struct consists of the column names of its fields in declaration order,
+// and it is written even if gen is empty.
+// The header of a map consists of the sorted keys of the first value, and missing keys are written as empty strings.
+// Map values of map[string]any are formatted by fmt.Sprint.
+// It stops at the first error and returns it.
+func Encode[T any](w io.Writer, gen itermania.Gen[T]) error {
+	return EncodeE(w, itermania.Lift(gen))
+}
+
+// EncodeE writes all values of gen into w as CSV rows following a header, in the same way as Encode.
+//
+// It stops at the first error reported by gen or the writer and returns it.
+// Rows written before the error are flushed into w.
+func EncodeE[T any](w io.Writer, gen itermania.GenE[T]) error {
+	typ := reflect.TypeFor[T]()
+	if !isStringMap(typ) && typ.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, typ)
+	}
+
+	cw := stdcsv.NewWriter(w)
+	// rows written before an error are not lost
+	defer cw.Flush()
+
+	var header []string
+	var fields []reflect.StructField
+	// the header of a struct is known even if gen is empty
+	if typ.Kind() == reflect.Struct {
+		header, fields = columns(typ)
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for v, err := range gen() {
+		if err != nil {
+			return err
+		}
+
+		rv := reflect.ValueOf(v)
+		if header == nil {
+			header = mapHeader(rv)
+			if err := cw.Write(header); err != nil {
+				return err
+			}
+		}
+
+		record, err := encodeRecord(rv, header, fields)
+		if err != nil {
+			return err
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func mapHeader(v reflect.Value) []string {
+	header := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		header = append(header, k.String())
+	}
+	slices.Sort(header)
+	return header
+}
+
+func encodeRecord(v reflect.Value, header []string, fields []reflect.StructField) ([]string, error) {
+	record := make([]string, len(header))
+	for i, name := range header {
+		if v.Kind() == reflect.Struct {
+			s, err := format(v.FieldByIndex(fields[i].Index))
+			if err != nil {
+				return nil, fmt.Errorf("csv: column %q: %w", name, err)
+			}
+			record[i] = s
+			continue
+		}
+
+		elem := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !elem.IsValid() {
+			continue
+		}
+		record[i] = fmt.Sprint(elem.Interface())
+	}
+	return record, nil
+}
@@ -0,0 +1,231 @@
+package csv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+type user struct {
+	ID      int     `csv:"id"`
+	Name    string  `csv:"name"`
+	Score   float64 `csv:"score"`
+	Active  bool    `csv:"active"`
+	Ignored string  `csv:"-"`
+}
+
+func open(s string) itermania.Opener {
+	return itermania.FromReadSeeker(strings.NewReader(s))
+}
+
+func TestDecodeStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []user
+	}{
+		{
+			"empty",
+			"",
+			[]user{},
+		},
+		{
+			"header only",
+			"id,name\n",
+			[]user{},
+		},
+		{
+			"rows",
+			"id,name,score,active\n1,alice,1.5,true\n2,bob,0,false\n",
+			[]user{{1, "alice", 1.5, true, ""}, {2, "bob", 0, false, ""}},
+		},
+		{
+			"columns in any order",
+			"name,unknown,id\nalice,x,1\n",
+			[]user{{ID: 1, Name: "alice"}},
+		},
+		{
+			"ignored column",
+			"id,-,Ignored\n1,a,b\n",
+			[]user{{ID: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := itermania.ToSliceE(Decode[user](open(tt.src)))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDecodeTextUnmarshaler(t *testing.T) {
+	type event struct {
+		At time.Time `csv:"at"`
+	}
+
+	actual, err := itermania.ToSliceE(Decode[event](open("at\n2024-01-02T03:04:05Z\n")))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []event{{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}}, actual)
+}
+
+func TestDecodeMap(t *testing.T) {
+	t.Run("map[string]string", func(t *testing.T) {
+		actual, err := itermania.ToSliceE(Decode[map[string]string](open("a,b\n1,2\n3,4\n")))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]string{{"a": "1", "b": "2"}, {"a": "3", "b": "4"}}, actual)
+	})
+
+	t.Run("map[string]any", func(t *testing.T) {
+		actual, err := itermania.ToSliceE(Decode[map[string]any](open("a,b\n1,2\n")))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"a": "1", "b": "2"}}, actual)
+	})
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   func() error
+		expected string
+	}{
+		{
+			"invalid number",
+			func() error {
+				_, err := itermania.ToSliceE(Decode[user](open("id\n1\nfoo\n")))
+				return err
+			},
+			`csv: record 2: column "id": strconv.ParseInt: parsing "foo": invalid syntax`,
+		},
+		{
+			"after multi-line field",
+			func() error {
+				_, err := itermania.ToSliceE(Decode[user](open("id,name\n1,\"a\nb\"\nfoo,c\n")))
+				return err
+			},
+			`csv: record 2: column "id": strconv.ParseInt: parsing "foo": invalid syntax`,
+		},
+		{
+			"wrong number of fields",
+			func() error {
+				_, err := itermania.ToSliceE(Decode[user](open("id,name\n1\n")))
+				return err
+			},
+			"record on line 2: wrong number of fields",
+		},
+		{
+			"unsupported type",
+			func() error {
+				_, err := itermania.ToSliceE(Decode[int](open("id\n1\n")))
+				return err
+			},
+			"csv: unsupported type: int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.actual(), tt.expected)
+		})
+	}
+}
+
+func TestDecodeStopsEarly(t *testing.T) {
+	// rows after the consumer stops are never decoded
+	actual := []user{}
+	for v, err := range Decode[user](open("id\n1\n2\nfoo\n"))() {
+		assert.NoError(t, err)
+		actual = append(actual, v)
+		if len(actual) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []user{{ID: 1}, {ID: 2}}, actual)
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		encode   func(*strings.Builder) error
+		expected string
+	}{
+		{
+			"struct",
+			func(w *strings.Builder) error {
+				return Encode(w, itermania.FromSlice([]user{{1, "alice", 1.5, true, "x"}, {2, "bob, jr.", 0, false, ""}}))
+			},
+			"id,name,score,active\n1,alice,1.5,true\n2,\"bob, jr.\",0,false\n",
+		},
+		{
+			"map",
+			func(w *strings.Builder) error {
+				return Encode(w, itermania.FromSlice([]map[string]any{{"b": 1, "a": "x"}, {"a": true}}))
+			},
+			"a,b\nx,1\ntrue,\n",
+		},
+		{
+			"empty struct",
+			func(w *strings.Builder) error {
+				return Encode(w, itermania.FromSlice([]user{}))
+			},
+			"id,name,score,active\n",
+		},
+		{
+			"empty map",
+			func(w *strings.Builder) error {
+				return Encode(w, itermania.FromSlice([]map[string]string{}))
+			},
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w strings.Builder
+			err := tt.encode(&w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, w.String())
+		})
+	}
+}
+
+func TestEncodeE(t *testing.T) {
+	errTest := errors.New("test error")
+
+	t.Run("error from generator", func(t *testing.T) {
+		var w strings.Builder
+		gen := itermania.ConcatE(itermania.Lift(itermania.FromSlice([]user{{ID: 1}})), itermania.Fail[user](errTest))
+
+		assert.ErrorIs(t, EncodeE(&w, gen), errTest)
+		// rows before the error are written
+		assert.Equal(t, "id,name,score,active\n1,,0,false\n", w.String())
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var w strings.Builder
+
+		assert.ErrorIs(t, Encode(&w, itermania.FromSlice([]int{1})), ErrUnsupportedType)
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	users := []user{{1, "alice", 1.5, true, ""}, {2, "bob", 2.25, false, ""}}
+
+	var w strings.Builder
+	assert.NoError(t, Encode(&w, itermania.FromSlice(users)))
+
+	actual, err := itermania.ToSliceE(Decode[user](open(w.String())))
+	assert.NoError(t, err)
+	assert.Equal(t, users, actual)
+}
@@ -0,0 +1,78 @@
+// Package jsonl streams JSON Lines records from and into generators.
+//
+// Records are decoded one by one while the generator is iterated,
+// so the whole input is never buffered the way itermania.ToSlice does.
+package jsonl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/syuparn/itermania"
+)
+
+// Decode returns an error-aware generator that iterates records of the reader decoded into T.
+//
+// T can be a struct with json tags, map[string]any or any type encoding/json accepts.
+// The decoder is lenient about line breaks: records are separated by any whitespace,
+// so a line may hold several records and a record may span lines.
+// Errors report the number of the record, not the line.
+// The reader is opened on every invocation and closed when the iteration finishes, fails or is stopped early.
+func Decode[T any](open itermania.Opener) itermania.GenE[T] {
+	return func() iter.Seq2[T, error] {
+		return func(yield func(T, error) bool) {
+			var zero T
+
+			rc, err := open()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			defer rc.Close()
+
+			dec := json.NewDecoder(rc)
+			for n := 1; ; n++ {
+				var v T
+				err := dec.Decode(&v)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					yield(zero, fmt.Errorf("jsonl: record %d: %w", n, err))
+					return
+				}
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Encode writes all values of gen into w, one JSON record per line.
+//
+// It stops at the first error and returns it.
+func Encode[T any](w io.Writer, gen itermania.Gen[T]) error {
+	return EncodeE(w, itermania.Lift(gen))
+}
+
+// EncodeE writes all values of gen into w, one JSON record per line.
+//
+// It stops at the first error reported by gen or the writer and returns it.
+func EncodeE[T any](w io.Writer, gen itermania.GenE[T]) error {
+	enc := json.NewEncoder(w)
+	for v, err := range gen() {
+		if err != nil {
+			return err
+		}
+
+		if err := enc.Encode(v); err != nil {
+			return fmt.Errorf("jsonl: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package jsonl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func open(s string) itermania.Opener {
+	return itermania.FromReadSeeker(strings.NewReader(s))
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []user
+	}{
+		{
+			"empty",
+			"",
+			[]user{},
+		},
+		{
+			"records",
+			"{\"id\":1,\"name\":\"alice\"}\n{\"id\":2,\"name\":\"bob\"}\n",
+			[]user{{1, "alice"}, {2, "bob"}},
+		},
+		{
+			"lenient about line breaks",
+			"{\"id\":1} {\"id\":2}\n{\n\"id\":3\n}\n",
+			[]user{{ID: 1}, {ID: 2}, {ID: 3}},
+		},
+		{
+			"blank lines and no trailing newline",
+			"{\"id\":1}\n\n{\"id\":2}",
+			[]user{{ID: 1}, {ID: 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := itermania.ToSliceE(Decode[user](open(tt.src)))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDecodeMap(t *testing.T) {
+	actual, err := itermania.ToSliceE(Decode[map[string]any](open("{\"a\":1,\"b\":[true]}\n")))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"a": 1.0, "b": []any{true}}}, actual)
+}
+
+func TestDecodeError(t *testing.T) {
+	actual, err := itermania.ToSliceE(Decode[user](open("{\"id\":1}\n{\"id\":\"x\"}\n{\"id\":3}\n")))
+
+	assert.ErrorContains(t, err, "jsonl: record 2:")
+	assert.Equal(t, []user{{ID: 1}}, actual)
+}
+
+func TestDecodePipeline(t *testing.T) {
+	records := Decode[user](open("{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n{\"id\":4}\n"))
+	isEven := itermania.UniE(func(u user) (bool, error) {
+		return u.ID%2 == 0, nil
+	})
+
+	actual, err := itermania.ToSliceE(itermania.WhereE(records, isEven(records)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []user{{ID: 2}, {ID: 4}}, actual)
+}
+
+func TestEncode(t *testing.T) {
+	var w strings.Builder
+	err := Encode(&w, itermania.FromSlice([]user{{1, "alice"}, {2, "bob"}}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"id\":1,\"name\":\"alice\"}\n{\"id\":2,\"name\":\"bob\"}\n", w.String())
+}
+
+func TestEncodeE(t *testing.T) {
+	errTest := errors.New("test error")
+
+	var w strings.Builder
+	gen := itermania.ConcatE(itermania.Lift(itermania.FromSlice([]int{1, 2})), itermania.Fail[int](errTest))
+	err := EncodeE(&w, gen)
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, "1\n2\n", w.String())
+}
+
+func TestRoundTrip(t *testing.T) {
+	users := []user{{1, "alice"}, {2, "bob"}}
+
+	var w strings.Builder
+	assert.NoError(t, Encode(&w, itermania.FromSlice(users)))
+
+	actual, err := itermania.ToSliceE(Decode[user](open(w.String())))
+	assert.NoError(t, err)
+	assert.Equal(t, users, actual)
+}
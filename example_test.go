@@ -5,7 +5,7 @@ import (
 	"strconv"
 )
 
-func ExamplePrimeNumbers() {
+func Example_primeNumbers() {
 	prime := Bind(Inc(2), func(n int) Gen[int] {
 		return Where(Const(n), All(Not(Eq(Mod(Const(n), Range(2, n, 1)), Const(0)))))
 	})
@@ -26,7 +26,7 @@ func ExamplePrimeNumbers() {
 	// 29
 }
 
-func ExampleFizzBuzz() {
+func Example_fizzBuzz() {
 	fizzbuzz := Bind(Inc(1), func(n int) Gen[string] {
 		return If(Eq(Mod(Const(n), Const(15)), Const(0)), Const("FizzBuzz"),
 			If(Eq(Mod(Const(n), Const(3)), Const(0)), Const("Fizz"),
@@ -54,3 +54,34 @@ func ExampleFizzBuzz() {
 	// 14
 	// FizzBuzz
 }
+
+func Example_fizzBuzzCond() {
+	fizzbuzz := Bind(Inc(1), func(n int) Gen[string] {
+		return Cond(
+			When(Eq(Mod(Const(n), Const(15)), Const(0)), Const("FizzBuzz")),
+			When(Eq(Mod(Const(n), Const(3)), Const(0)), Const("Fizz")),
+			When(Eq(Mod(Const(n), Const(5)), Const(0)), Const("Buzz")),
+			Else(Const(strconv.Itoa(n))),
+		)
+	})
+
+	for i := range Head(fizzbuzz, 15)() {
+		fmt.Println(i)
+	}
+	// Output:
+	// 1
+	// 2
+	// Fizz
+	// 4
+	// Buzz
+	// Fizz
+	// 7
+	// 8
+	// Fizz
+	// Buzz
+	// 11
+	// Fizz
+	// 13
+	// 14
+	// FizzBuzz
+}
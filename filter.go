@@ -0,0 +1,175 @@
+package itermania
+
+import "iter"
+
+// Filter returns a generator that iterates values only when pred is true.
+// It works as Where with a predicate function.
+func Filter[V any](gen Gen[V], pred func(V) bool) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				// skip if pred does not meet
+				if !pred(v) {
+					continue
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhile returns a generator that iterates values while pred is true.
+func TakeWhile[V any](gen Gen[V], pred func(V) bool) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				if !pred(v) {
+					return
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// DropWhile returns a generator that skips values while pred is true and then iterates the rest.
+func DropWhile[V any](gen Gen[V], pred func(V) bool) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			dropping := true
+			for v := range gen() {
+				if dropping && pred(v) {
+					continue
+				}
+				dropping = false
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeUntil returns a generator that iterates values until pred is true.
+//
+// Unlike TakeWhile, the value which meets pred is also iterated.
+func TakeUntil[V any](gen Gen[V], pred func(V) bool) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				if !yield(v) {
+					return
+				}
+
+				if pred(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Skip returns a generator that skips first n values in gen.
+func Skip[V any](gen Gen[V], n int) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			i := 0
+			for v := range gen() {
+				if i < n {
+					i++
+					continue
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Step returns a generator that iterates every n-th value in gen from the first one.
+//
+// It panics if n is not positive.
+func Step[V any](gen Gen[V], n int) Gen[V] {
+	if n <= 0 {
+		panic("itermania: n of Step must be positive")
+	}
+
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			i := 0
+			for v := range gen() {
+				skip := i%n != 0
+				i++
+				if skip {
+					continue
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileGen works as TakeWhile but the condition is iterated from condGen in lockstep like Where.
+func TakeWhileGen[V any](gen Gen[V], condGen Gen[bool]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for cond, v := range Zip2(condGen, gen)() {
+				if !cond {
+					return
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// DropWhileGen works as DropWhile but the condition is iterated from condGen in lockstep like Where.
+func DropWhileGen[V any](gen Gen[V], condGen Gen[bool]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			dropping := true
+			for cond, v := range Zip2(condGen, gen)() {
+				if dropping && cond {
+					continue
+				}
+				dropping = false
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeUntilGen works as TakeUntil but the condition is iterated from condGen in lockstep like Where.
+func TakeUntilGen[V any](gen Gen[V], condGen Gen[bool]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for cond, v := range Zip2(condGen, gen)() {
+				if !yield(v) {
+					return
+				}
+
+				if cond {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package itermania
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+func lessThan(n int) func(int) bool {
+	return func(i int) bool {
+		return i < n
+	}
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		pred     func(int) bool
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			isEven,
+			[]int{},
+		},
+		{
+			"even",
+			Range(0, 6, 1),
+			isEven,
+			[]int{0, 2, 4},
+		},
+		{
+			"infinite",
+			Head(Filter(Inc(0), isEven), 3),
+			func(int) bool { return true },
+			[]int{0, 2, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Filter(tt.gen, tt.pred))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		pred     func(int) bool
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			lessThan(3),
+			[]int{},
+		},
+		{
+			"stop at first false",
+			FromSlice([]int{1, 2, 5, 1}),
+			lessThan(3),
+			[]int{1, 2},
+		},
+		{
+			"infinite",
+			Inc(0),
+			lessThan(3),
+			[]int{0, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(TakeWhile(tt.gen, tt.pred))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		pred     func(int) bool
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			lessThan(3),
+			[]int{},
+		},
+		{
+			"drop only leading values",
+			FromSlice([]int{1, 2, 5, 1}),
+			lessThan(3),
+			[]int{5, 1},
+		},
+		{
+			"drop all",
+			FromSlice([]int{1, 2}),
+			lessThan(3),
+			[]int{},
+		},
+		{
+			"infinite",
+			Head(DropWhile(Inc(0), lessThan(3)), 2),
+			func(int) bool { return false },
+			[]int{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(DropWhile(tt.gen, tt.pred))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTakeUntil(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		pred     func(int) bool
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			isEven,
+			[]int{},
+		},
+		{
+			"include the matched value",
+			FromSlice([]int{1, 3, 4, 5}),
+			isEven,
+			[]int{1, 3, 4},
+		},
+		{
+			"never matched",
+			FromSlice([]int{1, 3}),
+			isEven,
+			[]int{1, 3},
+		},
+		{
+			"infinite",
+			Inc(1),
+			func(i int) bool { return i == 3 },
+			[]int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(TakeUntil(tt.gen, tt.pred))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		n        int
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			2,
+			[]int{},
+		},
+		{
+			"zero",
+			Range(0, 3, 1),
+			0,
+			[]int{0, 1, 2},
+		},
+		{
+			"skip some",
+			Range(0, 5, 1),
+			2,
+			[]int{2, 3, 4},
+		},
+		{
+			"skip all",
+			Range(0, 3, 1),
+			5,
+			[]int{},
+		},
+		{
+			"infinite",
+			Head(Skip(Inc(0), 3), 2),
+			0,
+			[]int{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Skip(tt.gen, tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		n        int
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			2,
+			[]int{},
+		},
+		{
+			"one",
+			Range(0, 3, 1),
+			1,
+			[]int{0, 1, 2},
+		},
+		{
+			"every third",
+			Range(0, 8, 1),
+			3,
+			[]int{0, 3, 6},
+		},
+		{
+			"infinite",
+			Head(Step(Inc(0), 2), 3),
+			1,
+			[]int{0, 2, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Step(tt.gen, tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestStepPanics(t *testing.T) {
+	assert.Panics(t, func() { Step(Inc(0), 0) })
+}
+
+func TestPredicateGen(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []int
+	}{
+		{
+			"TakeWhileGen",
+			TakeWhileGen(Inc(0), FromSlice([]bool{true, true, false, true})),
+			[]int{0, 1},
+		},
+		{
+			"TakeWhileGen with shorter cond",
+			TakeWhileGen(Inc(0), FromSlice([]bool{true})),
+			[]int{0},
+		},
+		{
+			"DropWhileGen",
+			DropWhileGen(Range(0, 5, 1), FromSlice([]bool{true, true, false, true, true})),
+			[]int{2, 3, 4},
+		},
+		{
+			"DropWhileGen with infinite cond",
+			Head(DropWhileGen(Inc(0), Bin(func(i, _ int) bool { return i < 3 })(Inc(0), Const(0))), 2),
+			[]int{3, 4},
+		},
+		{
+			"TakeUntilGen",
+			TakeUntilGen(Inc(0), FromSlice([]bool{false, false, true, false})),
+			[]int{0, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(tt.gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
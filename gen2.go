@@ -0,0 +1,154 @@
+package itermania
+
+import "iter"
+
+// FromMap creates a generator which iterates over the map.
+//
+// NOTE: the iteration order is not specified, as with range over the map
+func FromMap[K comparable, V any](m map[K]V) Gen2[K, V] {
+	return func() iter.Seq2[K, V] {
+		return func(yield func(K, V) bool) {
+			for k, v := range m {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerate returns a generator of values in gen paired with their indices.
+func Enumerate[V any](gen Gen[V]) Gen2[int, V] {
+	return func() iter.Seq2[int, V] {
+		return func(yield func(int, V) bool) {
+			i := 0
+			for v := range gen() {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Zip2 returns a generator of key-value pairs iterated from kGen and vGen in lockstep.
+//
+// It stops when either of the generators stops.
+func Zip2[K, V any](kGen Gen[K], vGen Gen[V]) Gen2[K, V] {
+	return func() iter.Seq2[K, V] {
+		return func(yield func(K, V) bool) {
+			kSeq := kGen()
+
+			vSeq := vGen()
+			vNext, vStop := iter.Pull(vSeq)
+			defer vStop()
+
+			for k := range kSeq {
+				v, ok := vNext()
+				if !ok {
+					return
+				}
+
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns a generator of keys in gen.
+func Keys[K, V any](gen Gen2[K, V]) Gen[K] {
+	return func() iter.Seq[K] {
+		return func(yield func(K) bool) {
+			for k := range gen() {
+				if !yield(k) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Values returns a generator of values in gen.
+func Values[K, V any](gen Gen2[K, V]) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for _, v := range gen() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Head2 returns a generator to iterator first n pairs in gen.
+func Head2[K, V any](gen Gen2[K, V], n int) Gen2[K, V] {
+	return func() iter.Seq2[K, V] {
+		return func(yield func(K, V) bool) {
+			seq := gen()
+			next, stop := iter.Pull2(seq)
+			defer stop()
+			for range n {
+				k, v, ok := next()
+				if !ok {
+					return
+				}
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Where2 returns a generator that iterates pairs only when condGen is true.
+func Where2[K, V any](gen Gen2[K, V], condGen Gen[bool]) Gen2[K, V] {
+	return func() iter.Seq2[K, V] {
+		return func(yield func(K, V) bool) {
+			seq := gen()
+
+			condSeq := condGen()
+			condNext, condStop := iter.Pull(condSeq)
+			defer condStop()
+
+			for k, v := range seq {
+				cond, ok := condNext()
+				if !ok {
+					return
+				}
+
+				// skip if cond does not meet
+				if !cond {
+					continue
+				}
+
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Bind2 applies f to each pairs iterated from gen.
+func Bind2[K, V, L, W any](gen Gen2[K, V], f func(K, V) Gen2[L, W]) Gen2[L, W] {
+	return func() iter.Seq2[L, W] {
+		return func(yield func(L, W) bool) {
+			seq := gen()
+
+			for kVal, vVal := range seq {
+				lwGen := f(kVal, vVal)
+				lwSeq := lwGen()
+
+				for lVal, wVal := range lwSeq {
+					if !yield(lVal, wVal) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
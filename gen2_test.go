@@ -0,0 +1,175 @@
+package itermania
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// toPairs produces a slice of pairs iterated from gen.
+func toPairs[K, V any](gen Gen2[K, V]) []Pair[K, V] {
+	pairs := []Pair[K, V]{}
+	for k, v := range gen() {
+		pairs = append(pairs, Pair[K, V]{X: k, Y: v})
+	}
+	return pairs
+}
+
+func TestFromMap(t *testing.T) {
+	gen := FromMap(map[string]int{"a": 1, "b": 2})
+	actual := toPairs(gen)
+
+	assert.ElementsMatch(t, []Pair[string, int]{{"a", 1}, {"b", 2}}, actual)
+}
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[string]
+		expected []Pair[int, string]
+	}{
+		{
+			"empty",
+			FromSlice([]string{}),
+			[]Pair[int, string]{},
+		},
+		{
+			"multiple values",
+			FromSlice([]string{"a", "b", "c"}),
+			[]Pair[int, string]{{0, "a"}, {1, "b"}, {2, "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(Enumerate(tt.gen))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestZip2(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        Gen[string]
+		v        Gen[int]
+		expected []Pair[string, int]
+	}{
+		{
+			"same length",
+			FromSlice([]string{"a", "b"}),
+			FromSlice([]int{1, 2}),
+			[]Pair[string, int]{{"a", 1}, {"b", 2}},
+		},
+		{
+			"keys are shorter",
+			Const("a"),
+			Inc(1),
+			[]Pair[string, int]{{"a", 1}},
+		},
+		{
+			"values are shorter",
+			Loop("a"),
+			FromSlice([]int{1, 2}),
+			[]Pair[string, int]{{"a", 1}, {"a", 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(Zip2(tt.k, tt.v))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	gen := Keys(Enumerate(FromSlice([]string{"a", "b"})))
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []int{0, 1}, actual)
+}
+
+func TestValues(t *testing.T) {
+	gen := Values(Enumerate(FromSlice([]string{"a", "b"})))
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []string{"a", "b"}, actual)
+}
+
+func TestHead2(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen2[int, int]
+		n        int
+		expected []Pair[int, int]
+	}{
+		{
+			"empty",
+			Enumerate(Inc(10)),
+			0,
+			[]Pair[int, int]{},
+		},
+		{
+			"first 2 pairs",
+			Enumerate(Inc(10)),
+			2,
+			[]Pair[int, int]{{0, 10}, {1, 11}},
+		},
+		{
+			"more than iterator pairs",
+			Enumerate(Const(10)),
+			3,
+			[]Pair[int, int]{{0, 10}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(Head2(tt.gen, tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestWhere2(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen2[int, string]
+		cond     Gen[bool]
+		expected []Pair[int, string]
+	}{
+		{
+			"yield only if cond is true",
+			Enumerate(FromSlice([]string{"a", "b", "c"})),
+			FromSlice([]bool{true, false, true}),
+			[]Pair[int, string]{{0, "a"}, {2, "c"}},
+		},
+		{
+			"cond is shorter",
+			Enumerate(FromSlice([]string{"a", "b", "c"})),
+			Const(true),
+			[]Pair[int, string]{{0, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(Where2(tt.gen, tt.cond))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBind2(t *testing.T) {
+	gen := Bind2(Enumerate(FromSlice([]string{"a", "b"})), func(i int, s string) Gen2[string, int] {
+		return Zip2(Loop(s), Range(0, i+1, 1))
+	})
+	actual := toPairs(gen)
+
+	assert.Equal(t, []Pair[string, int]{{"a", 0}, {"b", 0}, {"b", 1}}, actual)
+}
@@ -0,0 +1,233 @@
+package itermania
+
+import (
+	"errors"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrDivisionByZero is reported by DivE and ModE instead of panicking.
+var ErrDivisionByZero = errors.New("itermania: division by zero")
+
+// Lift converts gen into an error-aware generator which never fails.
+func Lift[V any](gen Gen[V]) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			for v := range gen() {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Fail returns an error-aware generator which only reports err.
+func Fail[V any](err error) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			var zero V
+			yield(zero, err)
+		}
+	}
+}
+
+// ConcatE returns an error-aware generator that iterates all values of gens in order.
+//
+// The result stops at the first error reported by gens.
+func ConcatE[V any](gens ...GenE[V]) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			for _, gen := range gens {
+				for v, err := range gen() {
+					if !yield(v, err) || err != nil {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func DivE[V Number](xGen GenE[V], yGen GenE[V]) GenE[V] {
+	bin := BinE(func(xVal V, yVal V) (V, error) {
+		if yVal == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return xVal / yVal, nil
+	})
+	return bin(xGen, yGen)
+}
+
+func ModE[V constraints.Integer](xGen GenE[V], yGen GenE[V]) GenE[V] {
+	bin := BinE(func(xVal V, yVal V) (V, error) {
+		if yVal == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return xVal % yVal, nil
+	})
+	return bin(xGen, yGen)
+}
+
+// BinE returns an error-aware generator from two generators and a binary operation which may fail.
+//
+// The result stops at the first error reported by the generators or the operation.
+func BinE[V, W any](op func(V, V) (W, error)) func(GenE[V], GenE[V]) GenE[W] {
+	return func(xGen GenE[V], yGen GenE[V]) GenE[W] {
+		return func() iter.Seq2[W, error] {
+			return func(yield func(W, error) bool) {
+				var zero W
+
+				xSeq := xGen()
+				for x, err := range xSeq {
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+
+					ySeq := yGen()
+					for y, err := range ySeq {
+						if err != nil {
+							yield(zero, err)
+							return
+						}
+
+						w, err := op(x, y)
+						if err != nil {
+							yield(zero, err)
+							return
+						}
+
+						if !yield(w, nil) {
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// UniE returns an error-aware generator from a generator and a unary operation which may fail.
+//
+// The result stops at the first error reported by the generator or the operation.
+func UniE[V, W any](op func(V) (W, error)) func(GenE[V]) GenE[W] {
+	return func(xGen GenE[V]) GenE[W] {
+		return func() iter.Seq2[W, error] {
+			return func(yield func(W, error) bool) {
+				var zero W
+
+				xSeq := xGen()
+				for x, err := range xSeq {
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+
+					w, err := op(x)
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+
+					if !yield(w, nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// BindE applies f to each values iterated from gen.
+//
+// The result stops at the first error reported by gen or the generators returned by f.
+func BindE[V, W any](gen GenE[V], f func(V) GenE[W]) GenE[W] {
+	return func() iter.Seq2[W, error] {
+		return func(yield func(W, error) bool) {
+			var zero W
+
+			seq := gen()
+			for vVal, err := range seq {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				wGen := f(vVal)
+				wSeq := wGen()
+
+				for wVal, err := range wSeq {
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+
+					if !yield(wVal, nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// WhereE returns an error-aware generator that iterates values only when condGen is true.
+//
+// The result stops at the first error reported by gen or condGen.
+func WhereE[V any](gen GenE[V], condGen GenE[bool]) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			var zero V
+
+			seq := gen()
+
+			condSeq := condGen()
+			condNext, condStop := iter.Pull2(condSeq)
+			defer condStop()
+
+			for v, err := range seq {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				cond, err, ok := condNext()
+				if !ok {
+					return
+				}
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				// skip if cond does not meet
+				if !cond {
+					continue
+				}
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ToSliceE produces a slice iterated from gen.
+//
+// It stops at the first error and returns values iterated so far with the error.
+func ToSliceE[V any](gen GenE[V]) ([]V, error) {
+	seq := gen()
+	values := []V{}
+
+	for v, err := range seq {
+		if err != nil {
+			return values, err
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,275 @@
+package itermania
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errTest = errors.New("test error")
+
+// failAfter returns an error-aware generator which reports errTest after values of gen.
+func failAfter[V any](gen Gen[V]) GenE[V] {
+	return ConcatE(Lift(gen), Fail[V](errTest))
+}
+
+func TestLift(t *testing.T) {
+	actual, err := ToSliceE(Lift(Range(1, 4, 1)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func TestFail(t *testing.T) {
+	actual, err := ToSliceE(Fail[int](errTest))
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, []int{}, actual)
+}
+
+func TestToSliceE(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         GenE[int]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"no error",
+			Lift(Range(1, 3, 1)),
+			[]int{1, 2},
+			nil,
+		},
+		{
+			"error",
+			failAfter(Range(1, 3, 1)),
+			[]int{1, 2},
+			errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(tt.gen)
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDivE(t *testing.T) {
+	tests := []struct {
+		name        string
+		x           GenE[int]
+		y           GenE[int]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"[6] / [2]",
+			Lift(Const(6)),
+			Lift(Const(2)),
+			[]int{3},
+			nil,
+		},
+		{
+			"[6] / [2, 0, 3]",
+			Lift(Const(6)),
+			Lift(FromSlice([]int{2, 0, 3})),
+			[]int{3},
+			ErrDivisionByZero,
+		},
+		{
+			"x fails",
+			failAfter(Const(6)),
+			Lift(Const(2)),
+			[]int{3},
+			errTest,
+		},
+		{
+			"y fails",
+			Lift(FromSlice([]int{6, 4})),
+			failAfter(Const(2)),
+			[]int{3},
+			errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(DivE(tt.x, tt.y))
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestModE(t *testing.T) {
+	tests := []struct {
+		name        string
+		x           GenE[int]
+		y           GenE[int]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"[6] % [4]",
+			Lift(Const(6)),
+			Lift(Const(4)),
+			[]int{2},
+			nil,
+		},
+		{
+			"[6] % [4, 0]",
+			Lift(Const(6)),
+			Lift(FromSlice([]int{4, 0})),
+			[]int{2},
+			ErrDivisionByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(ModE(tt.x, tt.y))
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestUniE(t *testing.T) {
+	half := UniE(func(v int) (int, error) {
+		if v%2 != 0 {
+			return 0, errTest
+		}
+		return v / 2, nil
+	})
+
+	tests := []struct {
+		name        string
+		x           GenE[int]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"no error",
+			Lift(FromSlice([]int{2, 4})),
+			[]int{1, 2},
+			nil,
+		},
+		{
+			"op fails",
+			Lift(FromSlice([]int{2, 3, 4})),
+			[]int{1},
+			errTest,
+		},
+		{
+			"x fails",
+			failAfter(Const(2)),
+			[]int{1},
+			errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(half(tt.x))
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestBindE(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         GenE[int]
+		f           func(int) GenE[int]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"no error",
+			Lift(Range(1, 3, 1)),
+			func(i int) GenE[int] { return Lift(Range(0, i, 1)) },
+			[]int{0, 0, 1},
+			nil,
+		},
+		{
+			"gen fails",
+			failAfter(Range(1, 3, 1)),
+			func(i int) GenE[int] { return Lift(Const(i)) },
+			[]int{1, 2},
+			errTest,
+		},
+		{
+			"inner generator fails",
+			Lift(Range(1, 3, 1)),
+			func(i int) GenE[int] { return DivE(Lift(Const(6)), Lift(Const(i-1))) },
+			[]int{},
+			ErrDivisionByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(BindE(tt.gen, tt.f))
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWhereE(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         GenE[int]
+		cond        GenE[bool]
+		expected    []int
+		expectedErr error
+	}{
+		{
+			"no error",
+			Lift(Range(1, 5, 1)),
+			Lift(FromSlice([]bool{true, false, true, false})),
+			[]int{1, 3},
+			nil,
+		},
+		{
+			"gen fails",
+			failAfter(Range(1, 3, 1)),
+			Lift(Loop(true)),
+			[]int{1, 2},
+			errTest,
+		},
+		{
+			"cond fails",
+			Lift(Range(1, 5, 1)),
+			failAfter(FromSlice([]bool{true, true})),
+			[]int{1, 2},
+			errTest,
+		},
+		{
+			"cond is shorter",
+			Lift(Range(1, 5, 1)),
+			Lift(FromSlice([]bool{true, true})),
+			[]int{1, 2},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(WhereE(tt.gen, tt.cond))
+
+			assert.Equal(t, tt.expected, actual)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Package goal provides goal-directed operators following Icon semantics.
+//
+// Unlike the comparisons in itermania, which generate a bool for each pair of values,
+// a comparison in this package succeeds by generating its right operand and fails by generating nothing.
+// Since operands are iterated as the cartesian product,
+// a failure makes the comparison backtrack into the next pair of values.
+//
+// Arithmetic operators are already goal-directed in itermania, so use itermania.Add and so on for them.
+package goal
+
+import (
+	"iter"
+
+	"github.com/syuparn/itermania"
+	"golang.org/x/exp/constraints"
+)
+
+func Eq[V comparable](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal == yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+func Neq[V comparable](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal != yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+func Gt[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal > yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+func Lt[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal < yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+func Ge[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal >= yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+func Le[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	cmp := Cmp(func(xVal V, yVal V) bool {
+		return xVal <= yVal
+	})
+	return cmp(xGen, yGen)
+}
+
+// Cmp returns a goal-directed comparison from two generators and a predicate.
+//
+// The comparison generates y for each pair (x, y) where pred(x, y) holds.
+func Cmp[V any](pred func(V, V) bool) func(itermania.Gen[V], itermania.Gen[V]) itermania.Gen[V] {
+	return func(xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+		return func() iter.Seq[V] {
+			return func(yield func(V) bool) {
+				xSeq := xGen()
+				for x := range xSeq {
+					ySeq := yGen()
+
+					for y := range ySeq {
+						// backtrack if failed
+						if !pred(x, y) {
+							continue
+						}
+
+						if !yield(y) {
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// And works as a conjunction `e1 & e2` in Icon.
+// It generates all values of yGen for each value of xGen.
+func And[V, W any](xGen itermania.Gen[V], yGen itermania.Gen[W]) itermania.Gen[W] {
+	return itermania.Bind(xGen, func(V) itermania.Gen[W] {
+		return yGen
+	})
+}
+
+// Not works as `not e` in Icon.
+// It generates an empty struct only if gen fails, that is, gen generates no values.
+func Not[V any](gen itermania.Gen[V]) itermania.Gen[struct{}] {
+	return func() iter.Seq[struct{}] {
+		return func(yield func(struct{}) bool) {
+			for range gen() {
+				return
+			}
+
+			yield(struct{}{})
+		}
+	}
+}
+
+// If works as an if-expression in Icon.
+// It generates values of thenGen if condGen succeeds, otherwise it generates values of elseGen.
+//
+// Only the first value of condGen is evaluated, and only the selected branch is evaluated.
+func If[C, V any](condGen itermania.Gen[C], thenGen itermania.Gen[V], elseGen itermania.Gen[V]) itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			succeeded := false
+			for range condGen() {
+				succeeded = true
+				break
+			}
+
+			branch := elseGen
+			if succeeded {
+				branch = thenGen
+			}
+
+			for v := range branch() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
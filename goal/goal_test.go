@@ -0,0 +1,209 @@
+package goal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(itermania.Gen[int], itermania.Gen[int]) itermania.Gen[int]
+		x        itermania.Gen[int]
+		y        itermania.Gen[int]
+		expected []int
+	}{
+		{
+			"[3] < [0 to 9]",
+			Lt[int],
+			itermania.Const(3),
+			itermania.Range(0, 10, 1),
+			[]int{4, 5, 6, 7, 8, 9},
+		},
+		{
+			"[3] <= [0 to 9]",
+			Le[int],
+			itermania.Const(3),
+			itermania.Range(0, 10, 1),
+			[]int{3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			"[3] > [0 to 9]",
+			Gt[int],
+			itermania.Const(3),
+			itermania.Range(0, 10, 1),
+			[]int{0, 1, 2},
+		},
+		{
+			"[3] >= [0 to 9]",
+			Ge[int],
+			itermania.Const(3),
+			itermania.Range(0, 10, 1),
+			[]int{0, 1, 2, 3},
+		},
+		{
+			"[3] == [0 to 9]",
+			Eq[int],
+			itermania.Const(3),
+			itermania.Range(0, 10, 1),
+			[]int{3},
+		},
+		{
+			"[3] != [2 to 4]",
+			Neq[int],
+			itermania.Const(3),
+			itermania.Range(2, 5, 1),
+			[]int{2, 4},
+		},
+		{
+			"[1] == [2] fails",
+			Eq[int],
+			itermania.Const(1),
+			itermania.Const(2),
+			[]int{},
+		},
+		{
+			"[1, 2] < [2, 3]",
+			Lt[int],
+			itermania.FromSlice([]int{1, 2}),
+			itermania.FromSlice([]int{2, 3}),
+			[]int{2, 3, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := tt.op(tt.x, tt.y)
+			actual := itermania.ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCmpChain(t *testing.T) {
+	// 1 < x < 4 generates 4 for each x in (1, 4)
+	gen := Lt(Lt(itermania.Const(1), itermania.Range(0, 6, 1)), itermania.Const(4))
+	actual := itermania.ToSlice(gen)
+
+	assert.Equal(t, []int{4, 4}, actual)
+}
+
+func TestAnd(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        itermania.Gen[int]
+		y        itermania.Gen[string]
+		expected []string
+	}{
+		{
+			"success",
+			Lt(itermania.Const(1), itermania.Const(2)),
+			itermania.Const("ok"),
+			[]string{"ok"},
+		},
+		{
+			"failure",
+			Lt(itermania.Const(2), itermania.Const(1)),
+			itermania.Const("ok"),
+			[]string{},
+		},
+		{
+			"multiple successes",
+			Lt(itermania.Const(1), itermania.Range(0, 4, 1)),
+			itermania.FromSlice([]string{"a", "b"}),
+			[]string{"a", "b", "a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := And(tt.x, tt.y)
+			actual := itermania.ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNot(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      itermania.Gen[int]
+		expected []struct{}
+	}{
+		{
+			"success",
+			Lt(itermania.Const(1), itermania.Const(2)),
+			[]struct{}{},
+		},
+		{
+			"failure",
+			Lt(itermania.Const(2), itermania.Const(1)),
+			[]struct{}{{}},
+		},
+		{
+			"infinite success",
+			itermania.Inc(0),
+			[]struct{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Not(tt.gen)
+			actual := itermania.ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestIf(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     itermania.Gen[int]
+		expected []string
+	}{
+		{
+			"then",
+			Lt(itermania.Const(1), itermania.Const(2)),
+			[]string{"then"},
+		},
+		{
+			"else",
+			Lt(itermania.Const(2), itermania.Const(1)),
+			[]string{"else"},
+		},
+		{
+			"infinite condition",
+			Lt(itermania.Const(1), itermania.Inc(0)),
+			[]string{"then"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := If(tt.cond, itermania.Const("then"), itermania.Const("else"))
+			actual := itermania.ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBacktracking(t *testing.T) {
+	// pythagorean triples a^2 + b^2 == c^2 where a < b < c < 20
+	triples := itermania.Bind(itermania.Range(1, 20, 1), func(a int) itermania.Gen[[3]int] {
+		return itermania.Bind(Lt(itermania.Const(a), itermania.Range(1, 20, 1)), func(b int) itermania.Gen[[3]int] {
+			return itermania.Bind(Lt(itermania.Const(b), itermania.Range(1, 20, 1)), func(c int) itermania.Gen[[3]int] {
+				return And(Eq(itermania.Const(a*a+b*b), itermania.Const(c*c)), itermania.Const([3]int{a, b, c}))
+			})
+		})
+	})
+	actual := itermania.ToSlice(triples)
+
+	assert.Equal(t, [][3]int{{3, 4, 5}, {5, 12, 13}, {6, 8, 10}, {8, 15, 17}, {9, 12, 15}}, actual)
+}
@@ -19,7 +19,7 @@ func Const[V any](v V) Gen[V] {
 
 // Head returns a generator to iterator first n elements in gen.
 func Head[V any](gen Gen[V], n int) func() iter.Seq[V] {
-	return func() iter.Seq[V] {
+	return traced("Head", func() iter.Seq[V] {
 		return func(yield func(V) bool) {
 			seq := gen()
 			next, stop := iter.Pull(seq)
@@ -34,18 +34,19 @@ func Head[V any](gen Gen[V], n int) func() iter.Seq[V] {
 				}
 			}
 		}
-	}
+	})
 }
 
 // Inc returns a generator of integers increasing by one from v.
 func Inc[V constraints.Integer](v V) Gen[V] {
 	return func() iter.Seq[V] {
 		return func(yield func(V) bool) {
+			i := v
 			for {
-				if !yield(v) {
+				if !yield(i) {
 					return
 				}
-				v++
+				i++
 			}
 		}
 	}
@@ -55,11 +56,12 @@ func Inc[V constraints.Integer](v V) Gen[V] {
 func Dec[V constraints.Integer](v V) Gen[V] {
 	return func() iter.Seq[V] {
 		return func(yield func(V) bool) {
+			i := v
 			for {
-				if !yield(v) {
+				if !yield(i) {
 					return
 				}
-				v--
+				i--
 			}
 		}
 	}
@@ -85,9 +87,35 @@ func Range[V constraints.Integer](start, stop, step V) Gen[V] {
 	}
 }
 
+// Concat returns a generator that iterates all values of gens in order.
+func Concat[V any](gens ...Gen[V]) Gen[V] {
+	return namedConcat("Concat", gens...)
+}
+
+// namedConcat works as Concat but is traced as name.
+func namedConcat[V any](name string, gens ...Gen[V]) Gen[V] {
+	return traced(name, func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for _, gen := range gens {
+				for v := range gen() {
+					if !yield(v) {
+						return
+					}
+				}
+			}
+		}
+	})
+}
+
+// Alt works as an alternation `e1 | e2 | ...` in Icon.
+// It is an alias of Concat.
+func Alt[V any](gens ...Gen[V]) Gen[V] {
+	return namedConcat("Alt", gens...)
+}
+
 // Where returns a generator that iterates values only when condGen is true.
 func Where[V any](gen Gen[V], condGen Gen[bool]) Gen[V] {
-	return func() iter.Seq[V] {
+	return traced("Where", func() iter.Seq[V] {
 		return func(yield func(V) bool) {
 			seq := gen()
 
@@ -111,12 +139,15 @@ func Where[V any](gen Gen[V], condGen Gen[bool]) Gen[V] {
 				}
 			}
 		}
-	}
+	})
 }
 
 // Bind applies f to each values iterated from gen.
 func Bind[V, W any](gen Gen[V], f func(V) Gen[W]) Gen[W] {
-	return func() iter.Seq[W] {
+	point := tracePoint()
+	f = tracedFuncAt(point, "Bind.inner", f)
+
+	return tracedAt(point, "Bind", func() iter.Seq[W] {
 		return func(yield func(W) bool) {
 			seq := gen()
 
@@ -131,14 +162,14 @@ func Bind[V, W any](gen Gen[V], f func(V) Gen[W]) Gen[W] {
 				}
 			}
 		}
-	}
+	})
 }
 
 // If works as an if-expression for generators.
 //
-// NOTE: regardless of cond, both then and else are always evaluated
+// NOTE: regardless of cond, both then and else are always evaluated (use LazyIf to evaluate only the chosen one)
 func If[V any](condGen Gen[bool], thenGen Gen[V], elseGen Gen[V]) Gen[V] {
-	return func() iter.Seq[V] {
+	return traced("If", func() iter.Seq[V] {
 		return func(yield func(V) bool) {
 			condSeq := condGen()
 			condNext, condStop := iter.Pull(condSeq)
@@ -176,11 +207,128 @@ func If[V any](condGen Gen[bool], thenGen Gen[V], elseGen Gen[V]) Gen[V] {
 				}
 			}
 		}
+	})
+}
+
+// LazyIf works as If but starts the generator of a branch only when it is chosen for the first time.
+//
+// The k-th value of the result is the k-th value of the chosen branch, just like If,
+// so a branch skips the values of the steps where it was not chosen.
+// Unlike If, a branch never chosen is never started, and an exhausted branch stops the result
+// only if it is chosen again.
+func LazyIf[V any](condGen Gen[bool], thenGen Gen[V], elseGen Gen[V]) Gen[V] {
+	return namedCond("LazyIf", When(condGen, thenGen), Else(elseGen))
+}
+
+// Case is a pair of a condition and a branch of Cond.
+type Case[V any] struct {
+	Cond Gen[bool]
+	Then Gen[V]
+}
+
+// When returns a case of Cond which chooses thenGen if condGen is true.
+func When[V any](condGen Gen[bool], thenGen Gen[V]) Case[V] {
+	return Case[V]{Cond: condGen, Then: thenGen}
+}
+
+// Else returns a case of Cond which always chooses elseGen.
+func Else[V any](elseGen Gen[V]) Case[V] {
+	return Case[V]{Cond: Loop(true), Then: elseGen}
+}
+
+// Cond works as a multi-way conditional for generators.
+//
+// For the k-th step, the k-th values of all conditions are pulled, and the k-th value of the branch of
+// the first true condition is yielded. Nothing is yielded at the step if no conditions are true.
+// A branch is started only when it is chosen for the first time, and skips the values of the steps
+// where it was not chosen, so each value stays at its own step as in If.
+// The result stops when any of the conditions or the chosen branch stops.
+func Cond[V any](cases ...Case[V]) Gen[V] {
+	return namedCond("Cond", cases...)
+}
+
+// namedCond works as Cond but is traced as name.
+func namedCond[V any](name string, cases ...Case[V]) Gen[V] {
+	return traced(name, func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			if len(cases) == 0 {
+				return
+			}
+
+			conds := make([]*puller[bool], len(cases))
+			branches := make([]*puller[V], len(cases))
+			for i, c := range cases {
+				conds[i] = &puller[bool]{gen: c.Cond}
+				defer conds[i].stop()
+				branches[i] = &puller[V]{gen: c.Then}
+				defer branches[i].stop()
+			}
+
+			for k := 0; ; k++ {
+				// all conditions advance on every step to keep them aligned
+				chosen := -1
+				for i := range cases {
+					c, ok := conds[i].next()
+					if !ok {
+						return
+					}
+					if c && chosen < 0 {
+						chosen = i
+					}
+				}
+				if chosen < 0 {
+					continue
+				}
+
+				v, ok := branches[chosen].at(k)
+				if !ok {
+					return
+				}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// puller pulls values from gen, which is started on the first pull.
+type puller[V any] struct {
+	gen  Gen[V]
+	pull func() (V, bool)
+	halt func()
+	// number of values pulled so far
+	pos int
+}
+
+func (p *puller[V]) next() (V, bool) {
+	if p.pull == nil {
+		p.pull, p.halt = iter.Pull(p.gen())
+	}
+	p.pos++
+	return p.pull()
+}
+
+// at pulls the k-th value of gen, discarding values before it.
+// k must not be less than the number of values pulled so far.
+func (p *puller[V]) at(k int) (V, bool) {
+	for p.pos < k {
+		if _, ok := p.next(); !ok {
+			var zero V
+			return zero, false
+		}
+	}
+	return p.next()
+}
+
+func (p *puller[V]) stop() {
+	if p.halt != nil {
+		p.halt()
 	}
 }
 
 func All(gen Gen[bool]) Gen[bool] {
-	return func() iter.Seq[bool] {
+	return traced("All", func() iter.Seq[bool] {
 		return func(yield func(bool) bool) {
 			seq := gen()
 			for v := range seq {
@@ -192,11 +340,11 @@ func All(gen Gen[bool]) Gen[bool] {
 
 			yield(true)
 		}
-	}
+	})
 }
 
 func Any(gen Gen[bool]) Gen[bool] {
-	return func() iter.Seq[bool] {
+	return traced("Any", func() iter.Seq[bool] {
 		return func(yield func(bool) bool) {
 			seq := gen()
 			for v := range seq {
@@ -208,7 +356,7 @@ func Any(gen Gen[bool]) Gen[bool] {
 
 			yield(false)
 		}
-	}
+	})
 }
 
 // Loop returns a generator to iterate the argument v infinitely.
@@ -223,3 +371,53 @@ func Loop[V any](v V) Gen[V] {
 		}
 	}
 }
+
+// Repeat returns a generator to iterate gen repeatedly, like `|e` in Icon.
+//
+// It stops when an iteration of gen generates no values.
+func Repeat[V any](gen Gen[V]) Gen[V] {
+	return traced("Repeat", func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for {
+				empty := true
+				for v := range gen() {
+					empty = false
+					if !yield(v) {
+						return
+					}
+				}
+
+				if empty {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Limit returns a generator to iterate at most n values of gen for each n in nGen, like `e \ n` in Icon.
+//
+// Each value of nGen starts a fresh iteration of gen.
+func Limit[V any](gen Gen[V], nGen Gen[int]) Gen[V] {
+	return traced("Limit", func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for n := range nGen() {
+				if n <= 0 {
+					continue
+				}
+
+				i := 0
+				for v := range gen() {
+					if !yield(v) {
+						return
+					}
+
+					i++
+					if i >= n {
+						break
+					}
+				}
+			}
+		}
+	})
+}
@@ -2,6 +2,7 @@ package itermania
 
 import (
 	"iter"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -170,6 +171,74 @@ func TestRange(t *testing.T) {
 	}
 }
 
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		name     string
+		gens     []Gen[int]
+		expected []int
+	}{
+		{
+			"no generators",
+			[]Gen[int]{},
+			[]int{},
+		},
+		{
+			"one generator",
+			[]Gen[int]{Range(1, 4, 1)},
+			[]int{1, 2, 3},
+		},
+		{
+			"multiple generators",
+			[]Gen[int]{Const(1), Range(2, 4, 1), FromSlice([]int{4, 5})},
+			[]int{1, 2, 3, 4, 5},
+		},
+		{
+			"with empty generator",
+			[]Gen[int]{Const(1), FromSlice([]int{}), Const(2)},
+			[]int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Concat(tt.gens...)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestConcatIsLazy(t *testing.T) {
+	invoked := false
+	second := func() iter.Seq[int] {
+		invoked = true
+		return Const(100)()
+	}
+
+	gen := Head(Concat(Range(1, 4, 1), second), 3)
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []int{1, 2, 3}, actual)
+	assert.False(t, invoked)
+}
+
+func TestAlt(t *testing.T) {
+	// n is 3 or 5 or a multiple of 7
+	matches := func(n int) Gen[bool] {
+		return Any(Alt(
+			Eq(Const(n), Alt(Const(3), Const(5))),
+			Eq(Mod(Const(n), Const(7)), Const(0)),
+		))
+	}
+	gen := Bind(Range(1, 22, 1), func(n int) Gen[int] {
+		return Where(Const(n), matches(n))
+	})
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []int{3, 5, 7, 14, 21}, actual)
+}
+
 func TestWhere(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -337,6 +406,191 @@ func TestIf(t *testing.T) {
 	}
 }
 
+func TestLazyIf(t *testing.T) {
+	tests := []struct {
+		name     string
+		condG    Gen[bool]
+		thenG    Gen[int]
+		elseG    Gen[int]
+		expected []int
+	}{
+		{
+			"then",
+			Const(true),
+			Const(1),
+			Const(2),
+			[]int{1},
+		},
+		{
+			"else",
+			Const(false),
+			Const(1),
+			Const(2),
+			[]int{2},
+		},
+		{
+			"values stay at their steps",
+			FromSlice([]bool{true, false, true, false}),
+			FromSlice([]int{1, 2, 3, 4}),
+			FromSlice([]int{5, 6, 7, 8}),
+			[]int{1, 6, 3, 8},
+		},
+		{
+			"skipped values of the chosen branch are exhausted",
+			FromSlice([]bool{true, false, true, false}),
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{5, 6, 7, 8}),
+			[]int{1, 6},
+		},
+		{
+			"cond is shorter",
+			FromSlice([]bool{true}),
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{3, 4}),
+			[]int{1},
+		},
+		{
+			"unused branch is empty",
+			FromSlice([]bool{true, true}),
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{}),
+			[]int{1, 2},
+		},
+		{
+			"chosen branch is exhausted",
+			FromSlice([]bool{true, true}),
+			FromSlice([]int{1}),
+			FromSlice([]int{3, 4}),
+			[]int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := LazyIf(tt.condG, tt.thenG, tt.elseG)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestLazyIfStartsOnlyChosenBranch(t *testing.T) {
+	invoked := false
+	unused := func() iter.Seq[int] {
+		invoked = true
+		return Const(100)()
+	}
+
+	actual := ToSlice(LazyIf(Const(true), Const(1), unused))
+
+	assert.Equal(t, []int{1}, actual)
+	assert.False(t, invoked)
+}
+
+func TestLazyIfMatchesIf(t *testing.T) {
+	nums := Uni(strconv.Itoa)(Inc(1))
+	div3 := Eq(Mod(Inc(1), Const(3)), Const(0))
+
+	tests := []struct {
+		name  string
+		condG Gen[bool]
+		thenG Gen[string]
+		elseG Gen[string]
+	}{
+		{"fizz", div3, Loop("Fizz"), nums},
+		{"streams in both branches", div3, Uni(func(s string) string { return s + "!" })(nums), nums},
+		{"finite", FromSlice([]bool{false, true, true, false}), FromSlice([]string{"a", "b", "c", "d"}), FromSlice([]string{"w", "x", "y", "z"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := ToSlice(Head(If(tt.condG, tt.thenG, tt.elseG), 10))
+			actual := ToSlice(Head(LazyIf(tt.condG, tt.thenG, tt.elseG), 10))
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestCondFizzBuzzStreams(t *testing.T) {
+	nums := Inc(1)
+	divisible := func(d int) Gen[bool] {
+		return Eq(Mod(nums, Const(d)), Const(0))
+	}
+
+	gen := Cond(
+		When(divisible(15), Loop("FizzBuzz")),
+		When(divisible(3), Loop("Fizz")),
+		When(divisible(5), Loop("Buzz")),
+		Else(Uni(strconv.Itoa)(nums)),
+	)
+	actual := ToSlice(Head(gen, 15))
+
+	assert.Equal(t, []string{"1", "2", "Fizz", "4", "Buzz", "Fizz", "7", "8", "Fizz", "Buzz", "11", "Fizz", "13", "14", "FizzBuzz"}, actual)
+}
+
+func TestCond(t *testing.T) {
+	tests := []struct {
+		name     string
+		cases    []Case[string]
+		expected []string
+	}{
+		{
+			"no cases",
+			[]Case[string]{},
+			[]string{},
+		},
+		{
+			"first case",
+			[]Case[string]{When(Const(true), Const("a")), When(Const(true), Const("b"))},
+			[]string{"a"},
+		},
+		{
+			"second case",
+			[]Case[string]{When(Const(false), Const("a")), When(Const(true), Const("b"))},
+			[]string{"b"},
+		},
+		{
+			"else",
+			[]Case[string]{When(Const(false), Const("a")), Else(Const("b"))},
+			[]string{"b"},
+		},
+		{
+			"no cases are true",
+			[]Case[string]{When(Const(false), Const("a")), When(Const(false), Const("b"))},
+			[]string{},
+		},
+		{
+			"multiple steps",
+			[]Case[string]{
+				When(FromSlice([]bool{true, false, false, false}), FromSlice([]string{"a0", "a1", "a2", "a3"})),
+				When(FromSlice([]bool{true, true, false, false}), FromSlice([]string{"b0", "b1", "b2", "b3"})),
+				Else(FromSlice([]string{"c0", "c1", "c2", "c3"})),
+			},
+			[]string{"a0", "b1", "c2", "c3"},
+		},
+		{
+			"a condition stops",
+			[]Case[string]{
+				When(FromSlice([]bool{true, false, false}), Loop("a")),
+				When(FromSlice([]bool{true, false}), Loop("b")),
+				Else(Loop("c")),
+			},
+			[]string{"a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Cond(tt.cases...)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestAll(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -444,3 +698,120 @@ func TestLoop(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		n        int
+		expected []int
+	}{
+		{
+			"const",
+			Const(1),
+			3,
+			[]int{1, 1, 1},
+		},
+		{
+			"multiple values",
+			FromSlice([]int{1, 2}),
+			5,
+			[]int{1, 2, 1, 2, 1},
+		},
+		{
+			"infinite",
+			Inc(0),
+			3,
+			[]int{0, 1, 2},
+		},
+		{
+			"empty",
+			FromSlice([]int{}),
+			3,
+			[]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Head(Repeat(tt.gen), tt.n)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRepeatTerminatesWhenEmpty(t *testing.T) {
+	count := 0
+	gen := func() iter.Seq[int] {
+		count++
+		if count > 2 {
+			return FromSlice([]int{})()
+		}
+		return Const(count)()
+	}
+
+	actual := ToSlice(Repeat(gen))
+
+	assert.Equal(t, []int{1, 2}, actual)
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		nGen     Gen[int]
+		expected []int
+	}{
+		{
+			"one limit",
+			Inc(0),
+			Const(3),
+			[]int{0, 1, 2},
+		},
+		{
+			"multiple limits",
+			Inc(0),
+			FromSlice([]int{2, 3}),
+			[]int{0, 1, 0, 1, 2},
+		},
+		{
+			"zero limit",
+			Inc(0),
+			Const(0),
+			[]int{},
+		},
+		{
+			"limit is larger than gen",
+			Range(0, 2, 1),
+			Range(1, 4, 1),
+			[]int{0, 0, 1, 0, 1},
+		},
+		{
+			"repeated",
+			Repeat(FromSlice([]int{1, 2})),
+			Const(5),
+			[]int{1, 2, 1, 2, 1},
+		},
+		{
+			"negative limit",
+			Inc(0),
+			FromSlice([]int{-1, 2}),
+			[]int{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Limit(tt.gen, tt.nGen)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+
+			// Limit works as Bind and Head
+			expected := ToSlice(Bind(tt.nGen, func(n int) Gen[int] { return Head(tt.gen, n) }))
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
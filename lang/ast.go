@@ -0,0 +1,138 @@
+package lang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Node is a node of an abstract syntax tree.
+type Node interface {
+	// Pos returns the position of the node in the source.
+	Pos() Pos
+	// String returns the node as a fully parenthesized expression.
+	String() string
+}
+
+// IntLit is an integer literal.
+type IntLit struct {
+	At    Pos
+	Value int
+}
+
+// StringLit is a string literal.
+type StringLit struct {
+	At    Pos
+	Value string
+}
+
+// BoolLit is a boolean literal.
+type BoolLit struct {
+	At    Pos
+	Value bool
+}
+
+// Ident is a reference to a variable.
+type Ident struct {
+	At   Pos
+	Name string
+}
+
+// Unary is a unary operation such as `-x` or `not x`.
+type Unary struct {
+	At Pos
+	Op TokenType
+	X  Node
+}
+
+// Binary is a binary operation such as `x + y` or `x | y`.
+type Binary struct {
+	At Pos
+	Op TokenType
+	X  Node
+	Y  Node
+}
+
+// To is a range `from to to by by`. By is nil if omitted.
+type To struct {
+	At   Pos
+	From Node
+	To   Node
+	By   Node
+}
+
+// Call is a call of a builtin function.
+type Call struct {
+	At   Pos
+	Func string
+	Args []Node
+}
+
+// If is an if-expression. Else is nil if omitted.
+type If struct {
+	At   Pos
+	Cond Node
+	Then Node
+	Else Node
+}
+
+// Every is a loop `every name := gen do body`.
+type Every struct {
+	At   Pos
+	Name string
+	Gen  Node
+	Body Node
+}
+
+func (n *IntLit) Pos() Pos    { return n.At }
+func (n *StringLit) Pos() Pos { return n.At }
+func (n *BoolLit) Pos() Pos   { return n.At }
+func (n *Ident) Pos() Pos     { return n.At }
+func (n *Unary) Pos() Pos     { return n.At }
+func (n *Binary) Pos() Pos    { return n.At }
+func (n *To) Pos() Pos        { return n.At }
+func (n *Call) Pos() Pos      { return n.At }
+func (n *If) Pos() Pos        { return n.At }
+func (n *Every) Pos() Pos     { return n.At }
+
+func (n *IntLit) String() string    { return strconv.Itoa(n.Value) }
+func (n *StringLit) String() string { return strconv.Quote(n.Value) }
+func (n *BoolLit) String() string   { return strconv.FormatBool(n.Value) }
+func (n *Ident) String() string     { return n.Name }
+
+func (n *Unary) String() string {
+	if n.Op == NOT {
+		return fmt.Sprintf("(not %s)", n.X)
+	}
+	return fmt.Sprintf("(%s%s)", n.Op, n.X)
+}
+
+func (n *Binary) String() string {
+	return fmt.Sprintf("(%s %s %s)", n.X, n.Op, n.Y)
+}
+
+func (n *To) String() string {
+	if n.By == nil {
+		return fmt.Sprintf("(%s to %s)", n.From, n.To)
+	}
+	return fmt.Sprintf("(%s to %s by %s)", n.From, n.To, n.By)
+}
+
+func (n *Call) String() string {
+	args := make([]string, len(n.Args))
+	for i, arg := range n.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", n.Func, strings.Join(args, ", "))
+}
+
+func (n *If) String() string {
+	if n.Else == nil {
+		return fmt.Sprintf("(if %s then %s)", n.Cond, n.Then)
+	}
+	return fmt.Sprintf("(if %s then %s else %s)", n.Cond, n.Then, n.Else)
+}
+
+func (n *Every) String() string {
+	return fmt.Sprintf("(every %s := %s do %s)", n.Name, n.Gen, n.Body)
+}
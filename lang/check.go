@@ -0,0 +1,199 @@
+package lang
+
+// Type is a type of values generated by an expression.
+type Type int
+
+const (
+	Int Type = iota + 1
+	String
+	Bool
+)
+
+func (t Type) String() string {
+	switch t {
+	case Int:
+		return "int"
+	case String:
+		return "string"
+	case Bool:
+		return "bool"
+	default:
+		return "invalid"
+	}
+}
+
+// scope is an immutable chain of variables.
+type scope[V any] struct {
+	name   string
+	value  V
+	parent *scope[V]
+}
+
+func (s *scope[V]) with(name string, value V) *scope[V] {
+	return &scope[V]{name: name, value: value, parent: s}
+}
+
+func (s *scope[V]) lookup(name string) (V, bool) {
+	for cur := s; cur != nil; cur = cur.parent {
+		if cur.name == name {
+			return cur.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Check returns the type of values generated by node.
+func Check(node Node) (Type, error) {
+	return check(node, nil)
+}
+
+func check(node Node, types *scope[Type]) (Type, error) {
+	switch n := node.(type) {
+	case *IntLit:
+		return Int, nil
+	case *StringLit:
+		return String, nil
+	case *BoolLit:
+		return Bool, nil
+	case *Ident:
+		typ, ok := types.lookup(n.Name)
+		if !ok {
+			return 0, errorf(n.At, "undefined variable %s", n.Name)
+		}
+		return typ, nil
+	case *Unary:
+		x, err := check(n.X, types)
+		if err != nil {
+			return 0, err
+		}
+		return checkUnary(n, x)
+	case *Binary:
+		x, err := check(n.X, types)
+		if err != nil {
+			return 0, err
+		}
+		y, err := check(n.Y, types)
+		if err != nil {
+			return 0, err
+		}
+		return checkBinary(n, x, y)
+	case *To:
+		operands := []Node{n.From, n.To}
+		if n.By != nil {
+			operands = append(operands, n.By)
+		}
+		for _, operand := range operands {
+			typ, err := check(operand, types)
+			if err != nil {
+				return 0, err
+			}
+			if typ != Int {
+				return 0, errorf(operand.Pos(), "operand of to must be int but got %s", typ)
+			}
+		}
+		return Int, nil
+	case *Call:
+		args := make([]Type, len(n.Args))
+		for i, arg := range n.Args {
+			typ, err := check(arg, types)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = typ
+		}
+		return checkCall(n, args)
+	case *If:
+		cond, err := check(n.Cond, types)
+		if err != nil {
+			return 0, err
+		}
+		if cond != Bool {
+			return 0, errorf(n.Cond.Pos(), "condition must be bool but got %s", cond)
+		}
+
+		then, err := check(n.Then, types)
+		if err != nil {
+			return 0, err
+		}
+		if n.Else == nil {
+			return then, nil
+		}
+
+		els, err := check(n.Else, types)
+		if err != nil {
+			return 0, err
+		}
+		if then != els {
+			return 0, errorf(n.At, "branches must have the same type but got %s and %s", then, els)
+		}
+		return then, nil
+	case *Every:
+		gen, err := check(n.Gen, types)
+		if err != nil {
+			return 0, err
+		}
+		return check(n.Body, types.with(n.Name, gen))
+	default:
+		return 0, errorf(node.Pos(), "unknown node %T", node)
+	}
+}
+
+func checkUnary(n *Unary, x Type) (Type, error) {
+	switch {
+	case n.Op == MINUS && x == Int:
+		return Int, nil
+	case n.Op == NOT && x == Bool:
+		return Bool, nil
+	default:
+		return 0, errorf(n.At, "invalid operation %s %s", n.Op, x)
+	}
+}
+
+func checkBinary(n *Binary, x, y Type) (Type, error) {
+	if x != y {
+		return 0, errorf(n.At, "mismatched types %s %s %s", x, n.Op, y)
+	}
+
+	switch n.Op {
+	case BAR:
+		return x, nil
+	case EQ, NEQ:
+		return Bool, nil
+	case LT, LE, GT, GE:
+		if x == Int || x == String {
+			return Bool, nil
+		}
+	case PLUS:
+		if x == Int || x == String {
+			return x, nil
+		}
+	case MINUS, ASTERISK, SLASH, PERCENT:
+		if x == Int {
+			return Int, nil
+		}
+	case AND, OR:
+		if x == Bool {
+			return Bool, nil
+		}
+	}
+	return 0, errorf(n.At, "invalid operation %s %s %s", x, n.Op, y)
+}
+
+func checkCall(n *Call, args []Type) (Type, error) {
+	switch n.Func {
+	case "all", "any":
+		if len(args) != 1 || args[0] != Bool {
+			return 0, errorf(n.At, "%s takes one bool argument", n.Func)
+		}
+		return Bool, nil
+	case "write":
+		if len(args) != 1 {
+			return 0, errorf(n.At, "write takes one argument")
+		}
+		return args[0], nil
+	default:
+		return 0, errorf(n.At, "undefined function %s", n.Func)
+	}
+}
@@ -0,0 +1,72 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected Type
+	}{
+		{"int", "1", Int},
+		{"string", `"a"`, String},
+		{"bool", "false", Bool},
+		{"arithmetic", "1 + 2 * 3 % 4", Int},
+		{"string concatenation", `"a" + "b"`, String},
+		{"comparison", "1 < 2", Bool},
+		{"string comparison", `"a" < "b"`, Bool},
+		{"equality", "true = false", Bool},
+		{"alternation", `"a" | "b"`, String},
+		{"to", "1 to 10 by 2", Int},
+		{"if", `if 1 < 2 then "a" else "b"`, String},
+		{"if without else", "if true then 1", Int},
+		{"every", "every n := 1 to 3 do n < 2", Bool},
+		{"nested every", `every n := 1 to 3 do every s := "a" | "b" do s`, String},
+		{"all", "all(true | false)", Bool},
+		{"write", `write("a")`, String},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.src)
+			assert.NoError(t, err)
+
+			actual, err := Check(node)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCheckError(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{"undefined variable", "n + 1", "1:1: undefined variable n"},
+		{"variable out of scope", "(every n := 1 to 3 do n) + n", "1:28: undefined variable n"},
+		{"mismatched types", `1 + "a"`, "1:3: mismatched types int + string"},
+		{"invalid operation", "true + false", "1:6: invalid operation bool + bool"},
+		{"invalid unary operation", "not 1", "1:1: invalid operation not int"},
+		{"invalid to", `1 to "a"`, "1:6: operand of to must be int but got string"},
+		{"invalid condition", "if 1 then 2", "1:4: condition must be bool but got int"},
+		{"mismatched branches", `if true then 1 else "a"`, "1:1: branches must have the same type but got int and string"},
+		{"invalid argument", "all(1)", "1:1: all takes one bool argument"},
+		{"undefined function", "f(1)", "1:1: undefined function f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.src)
+			assert.NoError(t, err)
+
+			_, err = Check(node)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package lang
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/syuparn/itermania"
+)
+
+// code is a compiled expression, which returns a generator under variables.
+type code = func(vars *scope[any]) itermania.Gen[any]
+
+// Compile compiles src into a generator.
+//
+// Values of the generator are int, string or bool according to the type of src.
+// Runtime errors such as division by zero panic as they do in Go.
+func Compile(src string) (itermania.Gen[any], error) {
+	node, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return CompileNode(node)
+}
+
+// CompileNode compiles node into a generator after checking its types.
+func CompileNode(node Node) (itermania.Gen[any], error) {
+	if _, err := Check(node); err != nil {
+		return nil, err
+	}
+	return compile(node)(nil), nil
+}
+
+// compile compiles a node whose types are already checked.
+func compile(node Node) code {
+	switch n := node.(type) {
+	case *IntLit:
+		return constant(n.Value)
+	case *StringLit:
+		return constant(n.Value)
+	case *BoolLit:
+		return constant(n.Value)
+	case *Ident:
+		return func(vars *scope[any]) itermania.Gen[any] {
+			v, _ := vars.lookup(n.Name)
+			return itermania.Const(v)
+		}
+	case *Unary:
+		x := compile(n.X)
+		uni := itermania.Uni(unaryOps[n.Op])
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return uni(x(vars))
+		}
+	case *Binary:
+		x := compile(n.X)
+		y := compile(n.Y)
+		if n.Op == BAR {
+			return func(vars *scope[any]) itermania.Gen[any] {
+				return itermania.Alt(x(vars), y(vars))
+			}
+		}
+
+		bin := itermania.Bin(binaryOps[n.Op])
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return bin(x(vars), y(vars))
+		}
+	case *To:
+		return compileTo(n)
+	case *Call:
+		return compileCall(n)
+	case *If:
+		cond := compile(n.Cond)
+		then := compile(n.Then)
+		if n.Else == nil {
+			return func(vars *scope[any]) itermania.Gen[any] {
+				return itermania.Where(then(vars), unbox[bool](cond(vars)))
+			}
+		}
+
+		els := compile(n.Else)
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return itermania.If(unbox[bool](cond(vars)), then(vars), els(vars))
+		}
+	case *Every:
+		gen := compile(n.Gen)
+		body := compile(n.Body)
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return itermania.Bind(gen(vars), func(v any) itermania.Gen[any] {
+				return body(vars.with(n.Name, v))
+			})
+		}
+	default:
+		panic(fmt.Sprintf("unknown node %T", node))
+	}
+}
+
+func compileTo(n *To) code {
+	from := compile(n.From)
+	to := compile(n.To)
+	by := constant(1)
+	if n.By != nil {
+		by = compile(n.By)
+	}
+
+	return func(vars *scope[any]) itermania.Gen[any] {
+		return itermania.Bind(from(vars), func(f any) itermania.Gen[any] {
+			return itermania.Bind(to(vars), func(t any) itermania.Gen[any] {
+				return itermania.Bind(by(vars), func(b any) itermania.Gen[any] {
+					return box(inclusiveRange(f.(int), t.(int), b.(int)))
+				})
+			})
+		})
+	}
+}
+
+// inclusiveRange returns a generator from start to stop inclusive like `to` in Icon.
+func inclusiveRange(start, stop, step int) itermania.Gen[int] {
+	switch {
+	case step > 0:
+		return itermania.Range(start, stop+1, step)
+	case step < 0:
+		return itermania.Range(start, stop-1, step)
+	default:
+		panic("lang: by 0 in to-expression")
+	}
+}
+
+func compileCall(n *Call) code {
+	arg := compile(n.Args[0])
+
+	switch n.Func {
+	case "all":
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return box(itermania.All(unbox[bool](arg(vars))))
+		}
+	case "any":
+		return func(vars *scope[any]) itermania.Gen[any] {
+			return box(itermania.Any(unbox[bool](arg(vars))))
+		}
+	default:
+		// write generates values of the argument as the result of the program
+		return arg
+	}
+}
+
+var unaryOps = map[TokenType]func(any) any{
+	MINUS: func(x any) any { return -x.(int) },
+	NOT:   func(x any) any { return !x.(bool) },
+}
+
+var binaryOps = map[TokenType]func(any, any) any{
+	PLUS: func(x, y any) any {
+		if s, ok := x.(string); ok {
+			return s + y.(string)
+		}
+		return x.(int) + y.(int)
+	},
+	MINUS:    func(x, y any) any { return x.(int) - y.(int) },
+	ASTERISK: func(x, y any) any { return x.(int) * y.(int) },
+	SLASH:    func(x, y any) any { return x.(int) / y.(int) },
+	PERCENT:  func(x, y any) any { return x.(int) % y.(int) },
+	EQ:       func(x, y any) any { return x == y },
+	NEQ:      func(x, y any) any { return x != y },
+	LT:       func(x, y any) any { return compare(x, y) < 0 },
+	LE:       func(x, y any) any { return compare(x, y) <= 0 },
+	GT:       func(x, y any) any { return compare(x, y) > 0 },
+	GE:       func(x, y any) any { return compare(x, y) >= 0 },
+	AND:      func(x, y any) any { return x.(bool) && y.(bool) },
+	OR:       func(x, y any) any { return x.(bool) || y.(bool) },
+}
+
+func compare(x, y any) int {
+	if s, ok := x.(string); ok {
+		return cmp.Compare(s, y.(string))
+	}
+	return cmp.Compare(x.(int), y.(int))
+}
+
+func constant(v any) code {
+	gen := itermania.Const(v)
+	return func(*scope[any]) itermania.Gen[any] {
+		return gen
+	}
+}
+
+func box[V any](gen itermania.Gen[V]) itermania.Gen[any] {
+	return itermania.Uni(func(v V) any { return v })(gen)
+}
+
+func unbox[V any](gen itermania.Gen[any]) itermania.Gen[V] {
+	return itermania.Uni(func(v any) V { return v.(V) })(gen)
+}
@@ -0,0 +1,99 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []any
+	}{
+		{"int", "1", []any{1}},
+		{"string", `"a"`, []any{"a"}},
+		{"arithmetic", "1 + 2 * 3 - 8 / 4 % 3", []any{5}},
+		{"unary", "-(1 + 2)", []any{-3}},
+		{"string concatenation", `"a" + "b"`, []any{"ab"}},
+		{"alternation", "1 | 2 | 3", []any{1, 2, 3}},
+		{"cartesian product", "(1 | 2) * (10 | 100)", []any{10, 100, 20, 200}},
+		{"comparison", "(1 | 2 | 3) < 2", []any{true, false, false}},
+		{"string comparison", `"a" >= "b"`, []any{false}},
+		{"equality", `"a" = ("a" | "b")`, []any{true, false}},
+		{"inequality", "1 ~= (1 | 2)", []any{false, true}},
+		{"and or", "true and false or not false", []any{true}},
+		{"to", "1 to 5", []any{1, 2, 3, 4, 5}},
+		{"to by", "1 to 5 by 2", []any{1, 3, 5}},
+		{"to by negative", "5 to 1 by -2", []any{5, 3, 1}},
+		{"to with generators", "(1 | 3) to 4", []any{1, 2, 3, 4, 3, 4}},
+		{"empty to", "2 to 1", []any{}},
+		{"if", `if true then "yes" else "no"`, []any{"yes"}},
+		{"if else", `if 1 > 2 then "yes" else "no"`, []any{"no"}},
+		{"if without else", `if false then "yes"`, []any{}},
+		{"every", "every n := 1 to 3 do n * n", []any{1, 4, 9}},
+		{"nested every", "every x := 1 to 2 do every y := x to 2 do x * 10 + y", []any{11, 12, 22}},
+		{"shadowing", "every n := 1 to 2 do every n := n * 10 do n", []any{10, 20}},
+		{"all", "all((1 | 2) > 0)", []any{true}},
+		{"any", "any((1 | 2) > 1)", []any{true}},
+		{"write", "write(1 | 2)", []any{1, 2}},
+		{
+			"fizzbuzz",
+			`every n := 1 to 5 do if n % 3 = 0 then "Fizz" else if n % 5 = 0 then "Buzz" else "-"`,
+			[]any{"-", "-", "Fizz", "-", "Buzz"},
+		},
+		{
+			"primes",
+			"every n := 2 to 30 do if all(n % (2 to n-1) ~= 0) then write(n)",
+			[]any{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := Compile(tt.src)
+			assert.NoError(t, err)
+
+			actual := itermania.ToSlice(gen)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCompileInfinite(t *testing.T) {
+	gen, err := Compile("every n := 1 to 1000000000 do n * 2")
+	assert.NoError(t, err)
+
+	actual := itermania.ToSlice(itermania.Head(gen, 3))
+	assert.Equal(t, []any{2, 4, 6}, actual)
+}
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{"syntax error", "1 +", "1:4: unexpected end of input"},
+		{"type error", "1 + true", "1:3: mismatched types int + bool"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.src)
+
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestCompileRuntimeError(t *testing.T) {
+	gen, err := Compile("1 to 10 by 0")
+	assert.NoError(t, err)
+
+	assert.Panics(t, func() {
+		itermania.ToSlice(gen)
+	})
+}
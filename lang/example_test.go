@@ -0,0 +1,29 @@
+package lang
+
+import (
+	"fmt"
+
+	"github.com/syuparn/itermania"
+)
+
+func Example_primeNumbers() {
+	prime, err := Compile("every n := 2 to 100 do if all(n % (2 to n-1) ~= 0) then write(n)")
+	if err != nil {
+		panic(err)
+	}
+
+	for i := range itermania.Head(prime, 10)() {
+		fmt.Println(i)
+	}
+	// Output:
+	// 2
+	// 3
+	// 5
+	// 7
+	// 11
+	// 13
+	// 17
+	// 19
+	// 23
+	// 29
+}
@@ -0,0 +1,179 @@
+package lang
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Lexer splits a source into tokens.
+type Lexer struct {
+	src  string
+	off  int
+	line int
+	col  int
+}
+
+// NewLexer returns a lexer of src.
+func NewLexer(src string) *Lexer {
+	return &Lexer{src: src, line: 1, col: 1}
+}
+
+// Tokenize splits src into tokens terminated by EOF.
+func Tokenize(src string) ([]Token, error) {
+	l := NewLexer(src)
+	tokens := []Token{}
+	for {
+		tok, err := l.Next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		if tok.Type == EOF {
+			return tokens, nil
+		}
+	}
+}
+
+// Next returns the next token.
+func (l *Lexer) Next() (Token, error) {
+	l.skipSpaces()
+
+	pos := l.pos()
+	if l.off >= len(l.src) {
+		return Token{Type: EOF, Pos: pos}, nil
+	}
+
+	r := l.peek()
+	switch {
+	case isLetter(r):
+		lit := l.readWhile(func(r rune) bool { return isLetter(r) || isDigit(r) })
+		if typ, ok := keywords[lit]; ok {
+			return Token{Type: typ, Literal: lit, Pos: pos}, nil
+		}
+		return Token{Type: IDENT, Literal: lit, Pos: pos}, nil
+	case isDigit(r):
+		lit := l.readWhile(isDigit)
+		return Token{Type: INT, Literal: lit, Pos: pos}, nil
+	case r == '"':
+		return l.readString()
+	}
+
+	// operators
+	for _, op := range []struct {
+		lit string
+		typ TokenType
+	}{
+		// longer ones first
+		{":=", ASSIGN},
+		{"~=", NEQ},
+		{"<=", LE},
+		{">=", GE},
+		{"+", PLUS},
+		{"-", MINUS},
+		{"*", ASTERISK},
+		{"/", SLASH},
+		{"%", PERCENT},
+		{"=", EQ},
+		{"<", LT},
+		{">", GT},
+		{"|", BAR},
+		{"(", LPAREN},
+		{")", RPAREN},
+		{",", COMMA},
+	} {
+		if len(l.src)-l.off >= len(op.lit) && l.src[l.off:l.off+len(op.lit)] == op.lit {
+			l.advance(len(op.lit))
+			return Token{Type: op.typ, Literal: op.lit, Pos: pos}, nil
+		}
+	}
+
+	return Token{}, errorf(pos, "unexpected character %q", r)
+}
+
+func (l *Lexer) readString() (Token, error) {
+	pos := l.pos()
+	start := l.off
+
+	// skip the opening quote
+	l.advance(1)
+	for {
+		if l.off >= len(l.src) || l.peek() == '\n' {
+			return Token{}, errorf(pos, "unterminated string")
+		}
+
+		r := l.peek()
+		l.step()
+		if r == '\\' && l.off < len(l.src) {
+			l.step()
+			continue
+		}
+		if r == '"' {
+			break
+		}
+	}
+
+	lit := l.src[start:l.off]
+	if _, err := strconv.Unquote(lit); err != nil {
+		return Token{}, errorf(pos, "invalid string %s", lit)
+	}
+	return Token{Type: STRING, Literal: lit, Pos: pos}, nil
+}
+
+func (l *Lexer) skipSpaces() {
+	for l.off < len(l.src) {
+		r := l.peek()
+		switch {
+		case unicode.IsSpace(r):
+			l.step()
+		case r == '#':
+			// comment until the end of the line
+			l.readWhile(func(r rune) bool { return r != '\n' })
+		default:
+			return
+		}
+	}
+}
+
+func (l *Lexer) readWhile(f func(rune) bool) string {
+	start := l.off
+	for l.off < len(l.src) && f(l.peek()) {
+		l.step()
+	}
+	return l.src[start:l.off]
+}
+
+func (l *Lexer) peek() rune {
+	r, _ := utf8.DecodeRuneInString(l.src[l.off:])
+	return r
+}
+
+// step advances the offset by one rune.
+func (l *Lexer) step() {
+	_, size := utf8.DecodeRuneInString(l.src[l.off:])
+	l.advance(size)
+}
+
+func (l *Lexer) advance(n int) {
+	for _, r := range l.src[l.off : l.off+n] {
+		if r == '\n' {
+			l.line++
+			l.col = 1
+		} else {
+			l.col++
+		}
+	}
+	l.off += n
+}
+
+func (l *Lexer) pos() Pos {
+	return Pos{Line: l.line, Col: l.col}
+}
+
+func isLetter(r rune) bool {
+	return r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
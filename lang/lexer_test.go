@@ -0,0 +1,138 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []Token
+	}{
+		{
+			"empty",
+			"",
+			[]Token{{EOF, "", Pos{1, 1}}},
+		},
+		{
+			"literals",
+			`1 "a\"b" foo`,
+			[]Token{
+				{INT, "1", Pos{1, 1}},
+				{STRING, `"a\"b"`, Pos{1, 3}},
+				{IDENT, "foo", Pos{1, 10}},
+				{EOF, "", Pos{1, 13}},
+			},
+		},
+		{
+			"operators",
+			":= + - * / % = ~= < <= > >= | ( ) ,",
+			[]Token{
+				{ASSIGN, ":=", Pos{1, 1}},
+				{PLUS, "+", Pos{1, 4}},
+				{MINUS, "-", Pos{1, 6}},
+				{ASTERISK, "*", Pos{1, 8}},
+				{SLASH, "/", Pos{1, 10}},
+				{PERCENT, "%", Pos{1, 12}},
+				{EQ, "=", Pos{1, 14}},
+				{NEQ, "~=", Pos{1, 16}},
+				{LT, "<", Pos{1, 19}},
+				{LE, "<=", Pos{1, 21}},
+				{GT, ">", Pos{1, 24}},
+				{GE, ">=", Pos{1, 26}},
+				{BAR, "|", Pos{1, 29}},
+				{LPAREN, "(", Pos{1, 31}},
+				{RPAREN, ")", Pos{1, 33}},
+				{COMMA, ",", Pos{1, 35}},
+				{EOF, "", Pos{1, 36}},
+			},
+		},
+		{
+			"keywords",
+			"every do if then else to by not and or true false",
+			[]Token{
+				{EVERY, "every", Pos{1, 1}},
+				{DO, "do", Pos{1, 7}},
+				{IF, "if", Pos{1, 10}},
+				{THEN, "then", Pos{1, 13}},
+				{ELSE, "else", Pos{1, 18}},
+				{TO, "to", Pos{1, 23}},
+				{BY, "by", Pos{1, 26}},
+				{NOT, "not", Pos{1, 29}},
+				{AND, "and", Pos{1, 33}},
+				{OR, "or", Pos{1, 37}},
+				{TRUE, "true", Pos{1, 40}},
+				{FALSE, "false", Pos{1, 45}},
+				{EOF, "", Pos{1, 50}},
+			},
+		},
+		{
+			"no spaces",
+			"n:=(1)~=-2",
+			[]Token{
+				{IDENT, "n", Pos{1, 1}},
+				{ASSIGN, ":=", Pos{1, 2}},
+				{LPAREN, "(", Pos{1, 4}},
+				{INT, "1", Pos{1, 5}},
+				{RPAREN, ")", Pos{1, 6}},
+				{NEQ, "~=", Pos{1, 7}},
+				{MINUS, "-", Pos{1, 9}},
+				{INT, "2", Pos{1, 10}},
+				{EOF, "", Pos{1, 11}},
+			},
+		},
+		{
+			"lines and comments",
+			"1 # comment\n  2",
+			[]Token{
+				{INT, "1", Pos{1, 1}},
+				{INT, "2", Pos{2, 3}},
+				{EOF, "", Pos{2, 4}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Tokenize(tt.src)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTokenizeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			"unexpected character",
+			"1 $ 2",
+			`1:3: unexpected character '$'`,
+		},
+		{
+			"unterminated string",
+			`"abc`,
+			`1:1: unterminated string`,
+		},
+		{
+			"invalid escape",
+			`"\q"`,
+			`1:1: invalid string "\q"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Tokenize(tt.src)
+
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
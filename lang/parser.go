@@ -0,0 +1,300 @@
+package lang
+
+import (
+	"slices"
+	"strconv"
+)
+
+// Parse parses src into an abstract syntax tree.
+//
+// The grammar is as follows (from the lowest precedence):
+//
+//	expr    := "every" IDENT ":=" expr "do" expr
+//	         | "if" expr "then" expr ["else" expr]
+//	         | or
+//	or      := and {"or" and}
+//	and     := to {"and" to}
+//	to      := alt ["to" alt ["by" alt]]
+//	alt     := cmp {"|" cmp}
+//	cmp     := sum [("=" | "~=" | "<" | "<=" | ">" | ">=") sum]
+//	sum     := term {("+" | "-") term}
+//	term    := unary {("*" | "/" | "%") unary}
+//	unary   := ("-" | "not") unary | primary
+//	primary := INT | STRING | "true" | "false" | IDENT ["(" [expr {"," expr}] ")"] | "(" expr ")"
+func Parse(src string) (Node, error) {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.Type != EOF {
+		return nil, errorf(tok.Pos, "unexpected %s", describe(tok))
+	}
+	return node, nil
+}
+
+type parser struct {
+	tokens []Token
+	cur    int
+}
+
+func (p *parser) peek() Token {
+	return p.tokens[p.cur]
+}
+
+func (p *parser) next() Token {
+	tok := p.tokens[p.cur]
+	// EOF is never consumed
+	if tok.Type != EOF {
+		p.cur++
+	}
+	return tok
+}
+
+func (p *parser) expect(typ TokenType) (Token, error) {
+	tok := p.next()
+	if tok.Type != typ {
+		return Token{}, errorf(tok.Pos, "expected %s but got %s", typ, describe(tok))
+	}
+	return tok, nil
+}
+
+func (p *parser) parseExpr() (Node, error) {
+	switch p.peek().Type {
+	case EVERY:
+		return p.parseEvery()
+	case IF:
+		return p.parseIf()
+	default:
+		return p.parseOr()
+	}
+}
+
+func (p *parser) parseEvery() (Node, error) {
+	tok := p.next()
+
+	name, err := p.expect(IDENT)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(ASSIGN); err != nil {
+		return nil, err
+	}
+	gen, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(DO); err != nil {
+		return nil, err
+	}
+	body, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Every{At: tok.Pos, Name: name.Literal, Gen: gen, Body: body}, nil
+}
+
+func (p *parser) parseIf() (Node, error) {
+	tok := p.next()
+
+	cond, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(THEN); err != nil {
+		return nil, err
+	}
+	then, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &If{At: tok.Pos, Cond: cond, Then: then}
+	if p.peek().Type == ELSE {
+		p.next()
+		node.Else, err = p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// parseBinary parses left-associative binary operations of ops.
+func (p *parser) parseBinary(operand func() (Node, error), ops ...TokenType) (Node, error) {
+	x, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for slices.Contains(ops, p.peek().Type) {
+		op := p.next()
+		y, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		x = &Binary{At: op.Pos, Op: op.Type, X: x, Y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseOr() (Node, error) {
+	return p.parseBinary(p.parseAnd, OR)
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	return p.parseBinary(p.parseTo, AND)
+}
+
+func (p *parser) parseTo() (Node, error) {
+	from, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().Type != TO {
+		return from, nil
+	}
+
+	tok := p.next()
+	to, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &To{At: tok.Pos, From: from, To: to}
+	if p.peek().Type == BY {
+		p.next()
+		node.By, err = p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func (p *parser) parseAlt() (Node, error) {
+	return p.parseBinary(p.parseCmp, BAR)
+}
+
+func (p *parser) parseCmp() (Node, error) {
+	x, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains([]TokenType{EQ, NEQ, LT, LE, GT, GE}, p.peek().Type) {
+		return x, nil
+	}
+
+	op := p.next()
+	y, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{At: op.Pos, Op: op.Type, X: x, Y: y}, nil
+}
+
+func (p *parser) parseSum() (Node, error) {
+	return p.parseBinary(p.parseTerm, PLUS, MINUS)
+}
+
+func (p *parser) parseTerm() (Node, error) {
+	return p.parseBinary(p.parseUnary, ASTERISK, SLASH, PERCENT)
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	switch p.peek().Type {
+	case MINUS, NOT:
+		op := p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{At: op.Pos, Op: op.Type, X: x}, nil
+	default:
+		return p.parsePrimary()
+	}
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	tok := p.next()
+
+	switch tok.Type {
+	case INT:
+		v, err := strconv.Atoi(tok.Literal)
+		if err != nil {
+			return nil, errorf(tok.Pos, "invalid integer %s", tok.Literal)
+		}
+		return &IntLit{At: tok.Pos, Value: v}, nil
+	case STRING:
+		v, err := strconv.Unquote(tok.Literal)
+		if err != nil {
+			return nil, errorf(tok.Pos, "invalid string %s", tok.Literal)
+		}
+		return &StringLit{At: tok.Pos, Value: v}, nil
+	case TRUE, FALSE:
+		return &BoolLit{At: tok.Pos, Value: tok.Type == TRUE}, nil
+	case IDENT:
+		if p.peek().Type == LPAREN {
+			return p.parseCall(tok)
+		}
+		return &Ident{At: tok.Pos, Name: tok.Literal}, nil
+	case LPAREN:
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(RPAREN); err != nil {
+			return nil, err
+		}
+		return x, nil
+	default:
+		return nil, errorf(tok.Pos, "unexpected %s", describe(tok))
+	}
+}
+
+func (p *parser) parseCall(name Token) (Node, error) {
+	// skip "("
+	p.next()
+
+	node := &Call{At: name.Pos, Func: name.Literal, Args: []Node{}}
+	if p.peek().Type == RPAREN {
+		p.next()
+		return node, nil
+	}
+
+	for {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		node.Args = append(node.Args, arg)
+
+		tok := p.next()
+		switch tok.Type {
+		case COMMA:
+			continue
+		case RPAREN:
+			return node, nil
+		default:
+			return nil, errorf(tok.Pos, "expected , or ) but got %s", describe(tok))
+		}
+	}
+}
+
+func describe(tok Token) string {
+	switch tok.Type {
+	case EOF:
+		return "end of input"
+	case INT, STRING, IDENT:
+		return tok.Literal
+	default:
+		return tok.Type.String()
+	}
+}
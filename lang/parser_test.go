@@ -0,0 +1,74 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{"int", "1", "1"},
+		{"string", `"foo"`, `"foo"`},
+		{"bool", "true", "true"},
+		{"ident", "n", "n"},
+		{"precedence of arithmetic", "1 + 2 * 3 - 4", "((1 + (2 * 3)) - 4)"},
+		{"parentheses", "(1 + 2) * 3", "((1 + 2) * 3)"},
+		{"unary", "-1 - -n", "((-1) - (-n))"},
+		{"comparison", "1 + 1 = 2", "((1 + 1) = 2)"},
+		{"alternation", "1 | 2 < 3 | 4", "((1 | (2 < 3)) | 4)"},
+		{"to", "1 to 10", "(1 to 10)"},
+		{"to by", "10 to 1 by -1", "(10 to 1 by (-1))"},
+		{"to with alternation", "1 | 2 to 3", "((1 | 2) to 3)"},
+		{"and or", "true or false and not true", "(true or (false and (not true)))"},
+		{"call", "all(n > 1)", "all((n > 1))"},
+		{"call without args", "f()", "f()"},
+		{"call with args", "f(1, 2 + 3)", "f(1, (2 + 3))"},
+		{"if", "if true then 1", "(if true then 1)"},
+		{"if else", "if true then 1 else 2", "(if true then 1 else 2)"},
+		{"every", "every n := 1 to 3 do n * 2", "(every n := (1 to 3) do (n * 2))"},
+		{
+			"primes",
+			"every n := 2 to 100 do if all(n % (2 to n-1) ~= 0) then write(n)",
+			"(every n := (2 to 100) do (if all(((n % (2 to (n - 1))) ~= 0)) then write(n)))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.src)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, node.String())
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{"empty", "", "1:1: unexpected end of input"},
+		{"trailing tokens", "1 2", "1:3: unexpected 2"},
+		{"unclosed parenthesis", "(1 + 2", "1:7: expected ) but got end of input"},
+		{"missing operand", "1 +", "1:4: unexpected end of input"},
+		{"missing do", "every n := 1 to 3 n", "1:19: expected do but got n"},
+		{"missing then", "if true 1", "1:9: expected then but got 1"},
+		{"invalid args", "f(1 2)", "1:5: expected , or ) but got 2"},
+		{"lexer error", "1 + $", "1:5: unexpected character '$'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.src)
+
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
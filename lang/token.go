@@ -0,0 +1,134 @@
+package lang
+
+import "fmt"
+
+// TokenType is a kind of tokens.
+type TokenType int
+
+const (
+	ILLEGAL TokenType = iota
+	EOF
+
+	INT
+	STRING
+	IDENT
+
+	ASSIGN   // :=
+	PLUS     // +
+	MINUS    // -
+	ASTERISK // *
+	SLASH    // /
+	PERCENT  // %
+	EQ       // =
+	NEQ      // ~=
+	LT       // <
+	LE       // <=
+	GT       // >
+	GE       // >=
+	BAR      // |
+	LPAREN   // (
+	RPAREN   // )
+	COMMA    // ,
+
+	EVERY
+	DO
+	IF
+	THEN
+	ELSE
+	TO
+	BY
+	NOT
+	AND
+	OR
+	TRUE
+	FALSE
+)
+
+var tokenNames = map[TokenType]string{
+	ILLEGAL:  "ILLEGAL",
+	EOF:      "EOF",
+	INT:      "INT",
+	STRING:   "STRING",
+	IDENT:    "IDENT",
+	ASSIGN:   ":=",
+	PLUS:     "+",
+	MINUS:    "-",
+	ASTERISK: "*",
+	SLASH:    "/",
+	PERCENT:  "%",
+	EQ:       "=",
+	NEQ:      "~=",
+	LT:       "<",
+	LE:       "<=",
+	GT:       ">",
+	GE:       ">=",
+	BAR:      "|",
+	LPAREN:   "(",
+	RPAREN:   ")",
+	COMMA:    ",",
+	EVERY:    "every",
+	DO:       "do",
+	IF:       "if",
+	THEN:     "then",
+	ELSE:     "else",
+	TO:       "to",
+	BY:       "by",
+	NOT:      "not",
+	AND:      "and",
+	OR:       "or",
+	TRUE:     "true",
+	FALSE:    "false",
+}
+
+func (t TokenType) String() string {
+	if name, ok := tokenNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("TokenType(%d)", int(t))
+}
+
+var keywords = map[string]TokenType{
+	"every": EVERY,
+	"do":    DO,
+	"if":    IF,
+	"then":  THEN,
+	"else":  ELSE,
+	"to":    TO,
+	"by":    BY,
+	"not":   NOT,
+	"and":   AND,
+	"or":    OR,
+	"true":  TRUE,
+	"false": FALSE,
+}
+
+// Pos is a position in a source.
+type Pos struct {
+	Line int
+	Col  int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Col)
+}
+
+// Token is a lexical token.
+type Token struct {
+	Type    TokenType
+	Literal string
+	Pos     Pos
+}
+
+// Error is an error in a source with its position.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+func errorf(pos Pos, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,189 @@
+package itermania
+
+import (
+	"container/list"
+	"iter"
+	"sync"
+)
+
+// MemoOptions configures MemoWith.
+type MemoOptions struct {
+	// MaxSize is the maximum number of cached values.
+	// If it is not positive, the cache is unbounded.
+	MaxSize int
+}
+
+// Memo returns a generator which records values of gen on the first iteration
+// and replays them on subsequent iterations.
+//
+// gen is iterated at most once and only as far as consumers need, so a consumer stopping early
+// leaves the rest of gen to be pulled lazily by the next consumer.
+// The result can be iterated concurrently.
+//
+// NOTE: the suspended iteration of gen holds its resources until a consumer reaches the end of gen
+func Memo[V any](gen Gen[V]) Gen[V] {
+	return MemoWith(gen, MemoOptions{})
+}
+
+// MemoWith works as Memo with options.
+//
+// Values after opts.MaxSize are not cached, and consumers requiring them iterate gen again from the start.
+func MemoWith[V any](gen Gen[V], opts MemoOptions) Gen[V] {
+	m := &memo[V]{gen: gen, maxSize: opts.MaxSize}
+
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for i := 0; ; i++ {
+				v, state := m.get(i)
+				switch state {
+				case memoEnd:
+					return
+				case memoFull:
+					m.replayFrom(i, yield)
+					return
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type memoState int
+
+const (
+	memoValue memoState = iota
+	// gen has no more values
+	memoEnd
+	// the value is not cached because the cache is full
+	memoFull
+)
+
+type memo[V any] struct {
+	gen     Gen[V]
+	maxSize int
+
+	// mu guards values and state
+	mu     sync.RWMutex
+	values []V
+	// state after the cached values (memoValue if gen is not pulled to the end yet)
+	state memoState
+
+	// pullMu serializes pulling from gen
+	pullMu sync.Mutex
+	next   func() (V, bool)
+	stop   func()
+}
+
+// get returns the i-th value of gen.
+func (m *memo[V]) get(i int) (V, memoState) {
+	if v, state, ok := m.cached(i); ok {
+		return v, state
+	}
+
+	m.pullMu.Lock()
+	defer m.pullMu.Unlock()
+
+	// another consumer may have pulled it
+	if v, state, ok := m.cached(i); ok {
+		return v, state
+	}
+
+	if m.next == nil {
+		m.next, m.stop = iter.Pull(m.gen())
+	}
+
+	var zero V
+	v, ok := m.next()
+	if !ok {
+		m.finish(memoEnd)
+		return zero, memoEnd
+	}
+
+	m.mu.Lock()
+	m.values = append(m.values, v)
+	full := m.maxSize > 0 && len(m.values) >= m.maxSize
+	m.mu.Unlock()
+
+	if full {
+		m.finish(memoFull)
+	}
+	return v, memoValue
+}
+
+// cached returns the i-th value if it is already known.
+func (m *memo[V]) cached(i int) (V, memoState, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var zero V
+	if i < len(m.values) {
+		return m.values[i], memoValue, true
+	}
+	if m.state != memoValue {
+		return zero, m.state, true
+	}
+	return zero, memoValue, false
+}
+
+// finish stops pulling from gen. pullMu must be locked.
+func (m *memo[V]) finish(state memoState) {
+	m.stop()
+
+	m.mu.Lock()
+	m.state = state
+	m.mu.Unlock()
+}
+
+// replayFrom yields values of gen from the i-th value by a fresh iteration.
+func (m *memo[V]) replayFrom(i int, yield func(V) bool) {
+	j := 0
+	for v := range m.gen() {
+		if j < i {
+			j++
+			continue
+		}
+
+		if !yield(v) {
+			return
+		}
+	}
+}
+
+// MemoFunc returns a function which memoizes generators returned by f for each argument by Memo.
+//
+// At most size generators are kept and the least recently used one is evicted.
+// If size is not positive, all generators are kept.
+func MemoFunc[K comparable, V any](f func(K) Gen[V], size int) func(K) Gen[V] {
+	var mu sync.Mutex
+	// elements of *memoEntry ordered from the most recently used
+	lru := list.New()
+	entries := map[K]*list.Element{}
+
+	return func(k K) Gen[V] {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if e, ok := entries[k]; ok {
+			lru.MoveToFront(e)
+			return e.Value.(*memoEntry[K, V]).gen
+		}
+
+		gen := Memo(f(k))
+		entries[k] = lru.PushFront(&memoEntry[K, V]{key: k, gen: gen})
+
+		if size > 0 && lru.Len() > size {
+			oldest := lru.Back()
+			lru.Remove(oldest)
+			delete(entries, oldest.Value.(*memoEntry[K, V]).key)
+		}
+		return gen
+	}
+}
+
+type memoEntry[K comparable, V any] struct {
+	key K
+	gen Gen[V]
+}
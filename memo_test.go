@@ -0,0 +1,142 @@
+package itermania
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// counted returns a generator which counts its invocations and produced values.
+func counted[V any](gen Gen[V], invocations, values *atomic.Int64) Gen[V] {
+	return func() iter.Seq[V] {
+		invocations.Add(1)
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				values.Add(1)
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestMemo(t *testing.T) {
+	var invocations, values atomic.Int64
+	gen := Memo(counted(Range(0, 5, 1), &invocations, &values))
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ToSlice(gen))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ToSlice(gen))
+	assert.Equal(t, []int{0, 1}, ToSlice(Head(gen, 2)))
+
+	assert.Equal(t, int64(1), invocations.Load())
+	assert.Equal(t, int64(5), values.Load())
+}
+
+func TestMemoEarlyExit(t *testing.T) {
+	var invocations, values atomic.Int64
+	gen := Memo(counted(Inc(0), &invocations, &values))
+
+	assert.Equal(t, []int{0, 1, 2}, ToSlice(Head(gen, 3)))
+	assert.Equal(t, int64(3), values.Load())
+
+	// the rest is pulled lazily
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ToSlice(Head(gen, 5)))
+	assert.Equal(t, int64(5), values.Load())
+
+	assert.Equal(t, []int{0, 1}, ToSlice(Head(gen, 2)))
+	assert.Equal(t, int64(5), values.Load())
+	assert.Equal(t, int64(1), invocations.Load())
+}
+
+func TestMemoEmpty(t *testing.T) {
+	gen := Memo(FromSlice([]int{}))
+
+	assert.Equal(t, []int{}, ToSlice(gen))
+	assert.Equal(t, []int{}, ToSlice(gen))
+}
+
+func TestMemoConcurrent(t *testing.T) {
+	var invocations, values atomic.Int64
+	gen := Memo(counted(Range(0, 100, 1), &invocations, &values))
+	expected := ToSlice(Range(0, 100, 1))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, expected, ToSlice(gen))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(1), invocations.Load())
+	assert.Equal(t, int64(100), values.Load())
+}
+
+func TestMemoWithMaxSize(t *testing.T) {
+	var invocations, values atomic.Int64
+	gen := MemoWith(counted(Range(0, 5, 1), &invocations, &values), MemoOptions{MaxSize: 3})
+
+	assert.Equal(t, []int{0, 1, 2}, ToSlice(Head(gen, 3)))
+	assert.Equal(t, int64(1), invocations.Load())
+
+	// values after MaxSize are produced by a fresh iteration
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ToSlice(gen))
+	assert.Equal(t, int64(2), invocations.Load())
+
+	assert.Equal(t, []int{0, 1, 2}, ToSlice(Head(gen, 3)))
+	assert.Equal(t, int64(2), invocations.Load())
+}
+
+func TestMemoWithBin(t *testing.T) {
+	var invocations, values atomic.Int64
+	y := Memo(counted(Range(0, 10, 1), &invocations, &values))
+
+	gen := Add(Range(0, 10, 1), y)
+
+	assert.Len(t, ToSlice(gen), 100)
+	assert.Equal(t, int64(1), invocations.Load())
+	assert.Equal(t, int64(10), values.Load())
+}
+
+func TestMemoFunc(t *testing.T) {
+	calls := map[int]int{}
+	f := MemoFunc(func(n int) Gen[int] {
+		calls[n]++
+		return Range(0, n, 1)
+	}, 2)
+
+	assert.Equal(t, []int{0}, ToSlice(f(1)))
+	assert.Equal(t, []int{0, 1}, ToSlice(f(2)))
+	assert.Equal(t, []int{0}, ToSlice(f(1)))
+	assert.Equal(t, map[int]int{1: 1, 2: 1}, calls)
+
+	// 2 is evicted as the least recently used
+	assert.Equal(t, []int{0, 1, 2}, ToSlice(f(3)))
+	assert.Equal(t, []int{0}, ToSlice(f(1)))
+	assert.Equal(t, []int{0, 1}, ToSlice(f(2)))
+	assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 1}, calls)
+}
+
+func TestMemoFuncUnbounded(t *testing.T) {
+	calls := map[int]int{}
+	f := MemoFunc(func(n int) Gen[int] {
+		calls[n]++
+		return Const(n)
+	}, 0)
+
+	for range 2 {
+		for i := range 10 {
+			assert.Equal(t, []int{i}, ToSlice(f(i)))
+		}
+	}
+
+	for i := range 10 {
+		assert.Equal(t, 1, calls[i])
+	}
+}
@@ -0,0 +1,36 @@
+package numtheory_test
+
+import (
+	"fmt"
+
+	"github.com/syuparn/itermania"
+	"github.com/syuparn/itermania/numtheory"
+)
+
+func ExamplePrimes() {
+	for p := range itermania.Head(numtheory.Primes[int](), 10)() {
+		fmt.Println(p)
+	}
+	// Output:
+	// 2
+	// 3
+	// 5
+	// 7
+	// 11
+	// 13
+	// 17
+	// 19
+	// 23
+	// 29
+}
+
+func ExampleFactorize() {
+	// numbers whose prime factors are all 2, 3 or 5
+	hamming := itermania.Bind(itermania.Inc(1), func(n int) itermania.Gen[int] {
+		return itermania.Where(itermania.Const(n), itermania.All(itermania.Le(numtheory.Factorize(n), itermania.Const(5))))
+	})
+
+	fmt.Println(itermania.ToSlice(itermania.Head(hamming, 12)))
+	// Output:
+	// [1 2 3 4 5 6 8 9 10 12 15 16]
+}
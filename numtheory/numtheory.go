@@ -0,0 +1,306 @@
+// Package numtheory provides lazy generators of number-theoretic sequences.
+//
+// All generators are restartable and iterate values of any integer type,
+// so they compose with itermania.Where, itermania.Bind and the binary operators.
+// Infinite sequences stop at the largest value representable by the type.
+package numtheory
+
+import (
+	"container/heap"
+	"iter"
+	"math"
+	"unsafe"
+
+	"github.com/syuparn/itermania"
+	"golang.org/x/exp/constraints"
+)
+
+// segmentSize is the number of integers sieved at a time by Primes.
+const segmentSize = 1 << 15
+
+// Primes returns a generator that iterates prime numbers in ascending order.
+//
+// It uses a segmented sieve of Eratosthenes. Only primes up to the square root of the current segment are kept
+// to sieve it, and they are generated lazily by another Primes, so memory usage grows only with the square root
+// of the largest prime iterated.
+func Primes[V constraints.Integer]() itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			limit := maxValue[V]()
+
+			// primes whose squares are less than the current segment end
+			base := []uint64{}
+			// next sieving prime, pulled from another Primes after the first segment
+			var nextBase func() (uint64, bool)
+			var pending uint64
+
+			composite := make([]bool, segmentSize)
+			for low := uint64(0); ; low += segmentSize {
+				high := low + segmentSize
+				clear(composite)
+
+				if low > 0 {
+					if nextBase == nil {
+						next, stop := iter.Pull(Primes[uint64]()())
+						defer stop()
+						nextBase = next
+						pending, _ = nextBase()
+					}
+					for pending*pending < high {
+						base = append(base, pending)
+						pending, _ = nextBase()
+					}
+				}
+
+				for _, p := range base {
+					start := max(p*p, (low+p-1)/p*p)
+					for m := start; m < high; m += p {
+						composite[m-low] = true
+					}
+				}
+
+				for i, c := range composite {
+					n := low + uint64(i)
+					if n < 2 || c {
+						continue
+					}
+					if n > limit {
+						return
+					}
+
+					// primes in the first segment mark their multiples in the same segment by themselves
+					// (n*n may overflow in later segments)
+					if low == 0 {
+						for m := n * n; m < high; m += n {
+							composite[m-low] = true
+						}
+					}
+
+					if !yield(V(n)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// PrimePowers returns a generator that iterates prime powers p^k (k >= 1) in ascending order.
+func PrimePowers[V constraints.Integer]() itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			limit := maxValue[V]()
+
+			// pending higher powers of primes already iterated
+			h := &powerHeap{}
+			flush := func(bound uint64) bool {
+				for h.Len() > 0 && (*h)[0].power < bound {
+					p := heap.Pop(h).(primePower)
+					if !yield(V(p.power)) {
+						return false
+					}
+					if p.power <= limit/p.prime {
+						heap.Push(h, primePower{p.power * p.prime, p.prime})
+					}
+				}
+				return true
+			}
+
+			for v := range Primes[V]()() {
+				p := uint64(v)
+				if !flush(p) {
+					return
+				}
+				if !yield(v) {
+					return
+				}
+				if p <= limit/p {
+					heap.Push(h, primePower{p * p, p})
+				}
+			}
+			flush(math.MaxUint64)
+		}
+	}
+}
+
+type primePower struct {
+	power uint64
+	prime uint64
+}
+
+// powerHeap implements heap.Interface ordered by power.
+type powerHeap []primePower
+
+func (h powerHeap) Len() int           { return len(h) }
+func (h powerHeap) Less(i, j int) bool { return h[i].power < h[j].power }
+func (h powerHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *powerHeap) Push(x any)        { *h = append(*h, x.(primePower)) }
+
+func (h *powerHeap) Pop() any {
+	old := *h
+	p := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return p
+}
+
+// Fibonacci returns a generator that iterates Fibonacci numbers 0, 1, 1, 2, 3, 5, ...
+func Fibonacci[V constraints.Integer]() itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			limit := maxValue[V]()
+
+			a, b := uint64(0), uint64(1)
+			for {
+				if !yield(V(a)) {
+					return
+				}
+				if b > limit {
+					return
+				}
+				if a > limit-b {
+					// a+b overflows, so b is the last one
+					yield(V(b))
+					return
+				}
+				a, b = b, a+b
+			}
+		}
+	}
+}
+
+// Factorize returns a generator that iterates prime factors of n in ascending order with multiplicity.
+//
+// Numbers less than 2 have no factors.
+func Factorize[V constraints.Integer](n V) itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			m := n
+			for d := V(2); d <= m/d; d = nextCandidate(d) {
+				for m%d == 0 {
+					if !yield(d) {
+						return
+					}
+					m /= d
+				}
+			}
+
+			if m >= 2 {
+				yield(m)
+			}
+		}
+	}
+}
+
+// nextCandidate returns the next trial divisor after d, skipping even numbers except 2.
+func nextCandidate[V constraints.Integer](d V) V {
+	if d == 2 {
+		return 3
+	}
+	return d + 2
+}
+
+// Divisors returns a generator that iterates positive divisors of n in ascending order.
+//
+// Numbers less than 1 have no divisors.
+func Divisors[V constraints.Integer](n V) itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			if n < 1 {
+				return
+			}
+
+			// divisors larger than sqrt(n), in descending order
+			large := []V{}
+			for d := V(1); d <= n/d; d++ {
+				if n%d != 0 {
+					continue
+				}
+
+				if !yield(d) {
+					return
+				}
+				if d != n/d {
+					large = append(large, n/d)
+				}
+			}
+
+			for i := len(large) - 1; i >= 0; i-- {
+				if !yield(large[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collatz returns a generator that iterates the Collatz sequence from n until it reaches 1.
+//
+// Numbers less than 1 have no sequence.
+// It panics if 3n+1 overflows V.
+func Collatz[V constraints.Integer](n V) itermania.Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			if n < 1 {
+				return
+			}
+			limit := maxValue[V]()
+
+			m := n
+			for {
+				if !yield(m) {
+					return
+				}
+				if m == 1 {
+					return
+				}
+
+				if m%2 == 0 {
+					m /= 2
+					continue
+				}
+				if uint64(m) > (limit-1)/3 {
+					panic("numtheory: Collatz sequence overflows")
+				}
+				m = 3*m + 1
+			}
+		}
+	}
+}
+
+// Digits returns a generator that iterates digits of n in base from the most significant one.
+//
+// Zero has a single digit 0.
+// It panics if n is negative or base is less than 2.
+func Digits[V constraints.Integer](n V, base V) itermania.Gen[V] {
+	if n < 0 {
+		panic("numtheory: n of Digits must not be negative")
+	}
+	if base < 2 {
+		panic("numtheory: base of Digits must be at least 2")
+	}
+
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			p := V(1)
+			for p <= n/base {
+				p *= base
+			}
+
+			for ; p > 0; p /= base {
+				if !yield(n / p % base) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// maxValue returns the largest value of V.
+func maxValue[V constraints.Integer]() uint64 {
+	var zero V
+	bits := unsafe.Sizeof(zero) * 8
+	if ^zero < 0 {
+		return 1<<(bits-1) - 1
+	}
+	return 1<<bits - 1
+}
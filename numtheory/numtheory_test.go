@@ -0,0 +1,248 @@
+package numtheory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+// trialDivision is the prime generator in README.
+func trialDivision() itermania.Gen[int] {
+	return itermania.Bind(itermania.Inc(2), func(n int) itermania.Gen[int] {
+		return itermania.Where(itermania.Const(n), itermania.All(itermania.Not(itermania.Eq(itermania.Mod(itermania.Const(n), itermania.Range(2, n, 1)), itermania.Const(0)))))
+	})
+}
+
+func isPrime(n int) bool {
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return n >= 2
+}
+
+func TestPrimes(t *testing.T) {
+	actual := itermania.ToSlice(itermania.Head(Primes[int](), 10))
+
+	assert.Equal(t, []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}, actual)
+}
+
+func TestPrimesAcrossSegments(t *testing.T) {
+	expected := []int{}
+	for n := range 3 * segmentSize {
+		if isPrime(n) {
+			expected = append(expected, n)
+		}
+	}
+
+	actual := itermania.ToSlice(itermania.Head(Primes[int](), len(expected)))
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestPrimesCount(t *testing.T) {
+	// there are 78498 primes below 10^6
+	actual := itermania.CountValue(itermania.TakeWhile(Primes[int](), func(p int) bool { return p < 1000000 }))
+
+	assert.Equal(t, 78498, actual)
+}
+
+func TestPrimesStopAtLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   func() []int
+		expected []int
+	}{
+		{
+			"int8",
+			func() []int {
+				return itermania.ToSlice(itermania.Uni(func(v int8) int { return int(v) })(Primes[int8]()))
+			},
+			[]int{113, 127},
+		},
+		{
+			"uint8",
+			func() []int {
+				return itermania.ToSlice(itermania.Uni(func(v uint8) int { return int(v) })(Primes[uint8]()))
+			},
+			[]int{241, 251},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.actual()
+
+			assert.Equal(t, tt.expected, actual[len(actual)-2:])
+		})
+	}
+}
+
+func TestPrimePowers(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		actual := itermania.ToSlice(itermania.Head(PrimePowers[int](), 15))
+
+		assert.Equal(t, []int{2, 3, 4, 5, 7, 8, 9, 11, 13, 16, 17, 19, 23, 25, 27}, actual)
+	})
+
+	t.Run("stop at limit", func(t *testing.T) {
+		actual := itermania.ToSlice(PrimePowers[uint8]())
+
+		assert.Equal(t, []uint8{241, 243, 251}, actual[len(actual)-3:])
+		assert.Contains(t, actual, uint8(128))
+		assert.IsIncreasing(t, actual)
+	})
+}
+
+func TestFibonacci(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		actual := itermania.ToSlice(itermania.Head(Fibonacci[int](), 10))
+
+		assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, actual)
+	})
+
+	t.Run("stop at limit", func(t *testing.T) {
+		actual := itermania.ToSlice(Fibonacci[uint8]())
+
+		assert.Equal(t, []uint8{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233}, actual)
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		actual := itermania.ToSlice(Fibonacci[uint64]())
+
+		// F(93) is the largest Fibonacci number in uint64
+		assert.Len(t, actual, 94)
+		assert.Equal(t, uint64(12200160415121876738), actual[93])
+	})
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"negative", -4, []int{}},
+		{"one", 1, []int{}},
+		{"prime", 13, []int{13}},
+		{"power of two", 32, []int{2, 2, 2, 2, 2}},
+		{"composite", 360, []int{2, 2, 2, 3, 3, 5}},
+		{"large prime factor", 2 * 1000003, []int{2, 1000003}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(Factorize(tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDivisors(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"zero", 0, []int{}},
+		{"one", 1, []int{1}},
+		{"prime", 7, []int{1, 7}},
+		{"square", 36, []int{1, 2, 3, 4, 6, 9, 12, 18, 36}},
+		{"composite", 28, []int{1, 2, 4, 7, 14, 28}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(Divisors(tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCollatz(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"zero", 0, []int{}},
+		{"one", 1, []int{1}},
+		{"six", 6, []int{6, 3, 10, 5, 16, 8, 4, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(Collatz(tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCollatzOverflow(t *testing.T) {
+	assert.Panics(t, func() { itermania.ToSlice(Collatz[int8](27)) })
+}
+
+func TestDigits(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		base     int
+		expected []int
+	}{
+		{"zero", 0, 10, []int{0}},
+		{"decimal", 1203, 10, []int{1, 2, 0, 3}},
+		{"binary", 6, 2, []int{1, 1, 0}},
+		{"hexadecimal", 255, 16, []int{15, 15}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(Digits(tt.n, tt.base))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDigitsLimit(t *testing.T) {
+	actual := itermania.ToSlice(Digits[uint8](255, 10))
+
+	assert.Equal(t, []uint8{2, 5, 5}, actual)
+}
+
+func TestDigitsPanics(t *testing.T) {
+	assert.Panics(t, func() { Digits(-1, 10) })
+	assert.Panics(t, func() { Digits(1, 1) })
+}
+
+func TestCompose(t *testing.T) {
+	// perfect numbers: the sum of proper divisors equals the number
+	perfect := itermania.Bind(itermania.Range(1, 10000, 1), func(n int) itermania.Gen[int] {
+		return itermania.Where(itermania.Const(n), itermania.Eq(itermania.Sum(Divisors(n)), itermania.Const(2*n)))
+	})
+
+	assert.Equal(t, []int{6, 28, 496, 8128}, itermania.ToSlice(perfect))
+}
+
+func BenchmarkPrimes(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		gen  itermania.Gen[int]
+	}{
+		{"Sieve", Primes[int]()},
+		{"TrialDivision", trialDivision()},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				itermania.ToSlice(itermania.Head(bm.gen, 1000))
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package itermania
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// ParOptions configures ParBind.
+type ParOptions struct {
+	// Workers is the number of goroutines to evaluate inner generators.
+	// If it is not positive, runtime.GOMAXPROCS(0) is used.
+	Workers int
+	// Ordered makes the result keep the order of the outer generator as Bind does.
+	// Otherwise values are yielded as soon as they are ready.
+	Ordered bool
+	// Buffer is the number of values buffered ahead of the consumer.
+	// If Ordered is true, each running inner generator has its own buffer.
+	Buffer int
+}
+
+// ParBind works as Bind but evaluates f and its inner generators on a pool of goroutines.
+//
+// Workers block when the buffers are full, so inner generators never run far ahead of the consumer.
+// When the consumer stops early, the iteration returns without waiting for the goroutines.
+// Each goroutine stops the next time gen or its inner generator yields, or when it finishes,
+// so an inner generator which never yields again keeps its goroutine.
+//
+// NOTE: gen is iterated on another goroutine, and panics in gen or f are not recovered
+func ParBind[V, W any](gen Gen[V], f func(V) Gen[W], opts ParOptions) Gen[W] {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	buffer := max(opts.Buffer, 0)
+
+	type job struct {
+		v V
+		// out receives values of the inner generator (only if Ordered is true)
+		out chan W
+	}
+
+	return func() iter.Seq[W] {
+		return func(yield func(W) bool) {
+			done := make(chan struct{})
+			defer close(done)
+
+			jobs := make(chan job)
+			// outputs of jobs in the order of gen
+			outs := make(chan chan W, workers)
+			// outputs of all jobs in the order of completion
+			results := make(chan W, buffer)
+
+			go func() {
+				defer close(jobs)
+				defer close(outs)
+
+				for v := range gen() {
+					j := job{v: v}
+					if opts.Ordered {
+						j.out = make(chan W, buffer)
+						select {
+						case outs <- j.out:
+						case <-done:
+							return
+						}
+					}
+
+					select {
+					case jobs <- j:
+					case <-done:
+						return
+					}
+				}
+			}()
+
+			var workerWg sync.WaitGroup
+			for range workers {
+				workerWg.Add(1)
+				go func() {
+					defer workerWg.Done()
+
+					for j := range jobs {
+						// do not start a job after the consumer stopped
+						select {
+						case <-done:
+							return
+						default:
+						}
+
+						out := results
+						if opts.Ordered {
+							out = j.out
+						}
+
+						for w := range f(j.v)() {
+							select {
+							case out <- w:
+							case <-done:
+								return
+							}
+						}
+
+						if opts.Ordered {
+							close(j.out)
+						}
+					}
+				}()
+			}
+
+			go func() {
+				workerWg.Wait()
+				close(results)
+			}()
+
+			if opts.Ordered {
+				for out := range outs {
+					for w := range out {
+						if !yield(w) {
+							return
+						}
+					}
+				}
+				return
+			}
+
+			for w := range results {
+				if !yield(w) {
+					return
+				}
+			}
+		}
+	}
+}
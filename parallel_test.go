@@ -0,0 +1,194 @@
+package itermania
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNoGoroutineLeak checks all goroutines started in f have stopped after f returns.
+func assertNoGoroutineLeak(t *testing.T, f func()) {
+	t.Helper()
+
+	before := runtime.NumGoroutine()
+	f()
+
+	// wait for goroutines to exit
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before, "goroutines leaked")
+}
+
+// assertReturns checks that f returns within timeout.
+func assertReturns(t *testing.T, timeout time.Duration, f func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatal("did not return in time")
+	}
+}
+
+func TestParBind(t *testing.T) {
+	f := func(i int) Gen[int] { return Range(0, i, 1) }
+
+	tests := []struct {
+		name string
+		gen  Gen[int]
+		opts ParOptions
+	}{
+		{
+			"ordered",
+			Range(0, 20, 1),
+			ParOptions{Workers: 4, Ordered: true},
+		},
+		{
+			"ordered with buffer",
+			Range(0, 20, 1),
+			ParOptions{Workers: 4, Ordered: true, Buffer: 3},
+		},
+		{
+			"ordered with one worker",
+			Range(0, 20, 1),
+			ParOptions{Workers: 1, Ordered: true},
+		},
+		{
+			"ordered with default workers",
+			Range(0, 20, 1),
+			ParOptions{Ordered: true},
+		},
+		{
+			"empty",
+			FromSlice([]int{}),
+			ParOptions{Workers: 4, Ordered: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := ToSlice(Bind(tt.gen, f))
+
+			assertNoGoroutineLeak(t, func() {
+				actual := ToSlice(ParBind(tt.gen, f, tt.opts))
+				assert.Equal(t, expected, actual)
+			})
+		})
+	}
+}
+
+func TestParBindUnordered(t *testing.T) {
+	f := func(i int) Gen[int] { return Range(0, i, 1) }
+
+	tests := []struct {
+		name string
+		gen  Gen[int]
+		opts ParOptions
+	}{
+		{
+			"unordered",
+			Range(0, 20, 1),
+			ParOptions{Workers: 4},
+		},
+		{
+			"unordered with buffer",
+			Range(0, 20, 1),
+			ParOptions{Workers: 4, Buffer: 3},
+		},
+		{
+			"empty",
+			FromSlice([]int{}),
+			ParOptions{Workers: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := ToSlice(Bind(tt.gen, f))
+
+			assertNoGoroutineLeak(t, func() {
+				actual := ToSlice(ParBind(tt.gen, f, tt.opts))
+				assert.ElementsMatch(t, expected, actual)
+			})
+		})
+	}
+}
+
+func TestParBindEarlyExit(t *testing.T) {
+	tests := []struct {
+		name string
+		opts ParOptions
+	}{
+		{"ordered", ParOptions{Workers: 4, Ordered: true, Buffer: 2}},
+		{"unordered", ParOptions{Workers: 4, Buffer: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("infinite outer generator", func(t *testing.T) {
+				assertNoGoroutineLeak(t, func() {
+					gen := ParBind(Inc(0), func(i int) Gen[int] { return Const(i) }, tt.opts)
+					actual := ToSlice(Head(gen, 5))
+					assert.Len(t, actual, 5)
+				})
+			})
+
+			t.Run("infinite inner generators", func(t *testing.T) {
+				assertNoGoroutineLeak(t, func() {
+					gen := ParBind(Inc(0), func(i int) Gen[int] { return Loop(i) }, tt.opts)
+					actual := ToSlice(Head(gen, 5))
+					assert.Len(t, actual, 5)
+				})
+			})
+
+			t.Run("slow inner generator", func(t *testing.T) {
+				release := make(chan struct{})
+				f := func(i int) Gen[int] {
+					if i != 3 {
+						return Const(i)
+					}
+					// blocks until released, like a long computation
+					return Bind(FromChan(release), func(struct{}) Gen[int] { return Const(i) })
+				}
+
+				assertNoGoroutineLeak(t, func() {
+					assertReturns(t, time.Second, func() {
+						actual := ToSlice(Head(ParBind(Inc(0), f, tt.opts), 2))
+						assert.Len(t, actual, 2)
+					})
+
+					close(release)
+				})
+			})
+		})
+	}
+}
+
+func TestParBindOrderedInfiniteInner(t *testing.T) {
+	gen := ParBind(Inc(0), func(i int) Gen[int] { return Loop(i) }, ParOptions{Workers: 4, Ordered: true})
+	actual := ToSlice(Head(gen, 3))
+
+	assert.Equal(t, []int{0, 0, 0}, actual)
+}
+
+func TestParBindPrimes(t *testing.T) {
+	isPrime := func(n int) Gen[int] {
+		return Where(Const(n), All(Not(Eq(Mod(Const(n), Range(2, n, 1)), Const(0)))))
+	}
+
+	expected := ToSlice(Head(Bind(Inc(2), isPrime), 50))
+	actual := ToSlice(Head(ParBind(Inc(2), isPrime, ParOptions{Workers: 4, Ordered: true}), 50))
+
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,194 @@
+package itermania
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"strings"
+)
+
+// Opener opens a new reader from the beginning of its source.
+//
+// Since a generator must be restartable, I/O generators call the opener on every invocation
+// instead of sharing a single reader.
+type Opener func() (io.ReadCloser, error)
+
+// OpenFile returns an opener which opens the named file on every call.
+func OpenFile(name string) Opener {
+	return func() (io.ReadCloser, error) {
+		return os.Open(name)
+	}
+}
+
+// FromReadSeeker returns an opener which rewinds rs to the beginning on every call.
+//
+// rs is not closed by the generators. Since rs is shared, the generators must not be
+// iterated concurrently.
+func FromReadSeeker(rs io.ReadSeeker) Opener {
+	return func() (io.ReadCloser, error) {
+		if _, err := rs.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(rs), nil
+	}
+}
+
+// SplitOptions configures SplitWith.
+type SplitOptions struct {
+	// MaxTokenSize is the maximum size of a token. If it is not positive, bufio.MaxScanTokenSize is used.
+	MaxTokenSize int
+}
+
+// Split returns an error-aware generator that iterates tokens of the reader split by split.
+//
+// Each token is a fresh copy and can be retained by the consumer.
+// A token longer than bufio.MaxScanTokenSize is reported as bufio.ErrTooLong. Use SplitWith for longer tokens.
+// The reader is closed when the iteration finishes, fails or is stopped early.
+func Split(open Opener, split bufio.SplitFunc) GenE[[]byte] {
+	return SplitWith(open, split, SplitOptions{})
+}
+
+// SplitWith works as Split but accepts tokens up to opts.MaxTokenSize.
+func SplitWith(open Opener, split bufio.SplitFunc, opts SplitOptions) GenE[[]byte] {
+	maxTokenSize := opts.MaxTokenSize
+	if maxTokenSize <= 0 {
+		maxTokenSize = bufio.MaxScanTokenSize
+	}
+
+	return func() iter.Seq2[[]byte, error] {
+		return func(yield func([]byte, error) bool) {
+			rc, err := open()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			defer rc.Close()
+
+			scanner := bufio.NewScanner(rc)
+			scanner.Buffer(make([]byte, 0, min(maxTokenSize, 4096)), maxTokenSize)
+			scanner.Split(split)
+			for scanner.Scan() {
+				if !yield(append([]byte{}, scanner.Bytes()...), nil) {
+					return
+				}
+			}
+
+			if err := scanner.Err(); err != nil {
+				yield(nil, err)
+			}
+		}
+	}
+}
+
+// Lines returns an error-aware generator that iterates lines of the reader without line terminators.
+//
+// Lines can be of any length. The last line may have no terminator.
+// The reader is closed when the iteration finishes, fails or is stopped early.
+func Lines(open Opener) GenE[string] {
+	return func() iter.Seq2[string, error] {
+		return func(yield func(string, error) bool) {
+			rc, err := open()
+			if err != nil {
+				yield("", err)
+				return
+			}
+			defer rc.Close()
+
+			br := bufio.NewReader(rc)
+			for {
+				line, err := br.ReadString('\n')
+				if err != nil && !errors.Is(err, io.EOF) {
+					yield("", err)
+					return
+				}
+				if line == "" {
+					return
+				}
+
+				line = strings.TrimSuffix(line, "\n")
+				line = strings.TrimSuffix(line, "\r")
+				if !yield(line, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Runes returns an error-aware generator that iterates UTF-8 decoded runes of the reader.
+//
+// Invalid encodings are iterated as utf8.RuneError.
+// The reader is closed when the iteration finishes, fails or is stopped early.
+func Runes(open Opener) GenE[rune] {
+	return func() iter.Seq2[rune, error] {
+		return func(yield func(rune, error) bool) {
+			rc, err := open()
+			if err != nil {
+				yield(0, err)
+				return
+			}
+			defer rc.Close()
+
+			br := bufio.NewReader(rc)
+			for {
+				r, _, err := br.ReadRune()
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					yield(0, err)
+					return
+				}
+
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Bytes returns an error-aware generator that iterates chunks of chunkSize bytes of the reader.
+//
+// Only the last chunk may be shorter than chunkSize. Each chunk is a fresh slice.
+// The reader is closed when the iteration finishes, fails or is stopped early.
+// It panics if chunkSize is not positive.
+func Bytes(open Opener, chunkSize int) GenE[[]byte] {
+	if chunkSize <= 0 {
+		panic("itermania: chunkSize of Bytes must be positive")
+	}
+
+	return func() iter.Seq2[[]byte, error] {
+		return func(yield func([]byte, error) bool) {
+			rc, err := open()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			defer rc.Close()
+
+			for {
+				chunk := make([]byte, chunkSize)
+				n, err := io.ReadFull(rc, chunk)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+					yield(nil, err)
+					return
+				}
+
+				if !yield(chunk[:n], nil) {
+					return
+				}
+
+				// the short chunk is the last one
+				if n < chunkSize {
+					return
+				}
+			}
+		}
+	}
+}
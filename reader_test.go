@@ -0,0 +1,216 @@
+package itermania
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tracked returns an opener of s which counts how many readers are left open.
+func tracked(s string) (Opener, *int) {
+	open := 0
+	return func() (io.ReadCloser, error) {
+		open++
+		return closeFunc{strings.NewReader(s), func() { open-- }}, nil
+	}, &open
+}
+
+type closeFunc struct {
+	io.Reader
+	f func()
+}
+
+func (c closeFunc) Close() error {
+	c.f()
+	return nil
+}
+
+func failingOpener(r io.Reader) Opener {
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(r), nil
+	}
+}
+
+func errOf[V any](gen GenE[V]) error {
+	_, err := ToSliceE(gen)
+	return err
+}
+
+func breakFirst[V any](gen GenE[V]) {
+	for range gen() {
+		break
+	}
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []string
+	}{
+		{"empty", "", []string{}},
+		{"lines", "foo\nbar\nbaz\n", []string{"foo", "bar", "baz"}},
+		{"no trailing newline", "foo\r\nbar", []string{"foo", "bar"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(Lines(FromReadSeeker(strings.NewReader(tt.src))))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRunes(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected []rune
+	}{
+		{"empty", "", []rune{}},
+		{"multibyte", "aあ😀", []rune{'a', 'あ', '😀'}},
+		{"invalid", "a\xff", []rune{'a', '�'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(Runes(FromReadSeeker(strings.NewReader(tt.src))))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		chunkSize int
+		expected  [][]byte
+	}{
+		{"empty", "", 2, [][]byte{}},
+		{"divisible", "abcd", 2, [][]byte{[]byte("ab"), []byte("cd")}},
+		{"last chunk is shorter", "abcde", 2, [][]byte{[]byte("ab"), []byte("cd"), []byte("e")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(Bytes(FromReadSeeker(strings.NewReader(tt.src)), tt.chunkSize))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBytesPanics(t *testing.T) {
+	assert.Panics(t, func() { Bytes(FromReadSeeker(strings.NewReader("")), 0) })
+}
+
+func TestSplit(t *testing.T) {
+	actual, err := ToSliceE(Split(FromReadSeeker(strings.NewReader("foo  bar\nbaz")), bufio.ScanWords))
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")}, actual)
+}
+
+func TestLongToken(t *testing.T) {
+	long := strings.Repeat("a", 70000)
+	src := long + "\nfoo\n"
+
+	t.Run("Lines", func(t *testing.T) {
+		actual, err := ToSliceE(Lines(FromReadSeeker(strings.NewReader(src))))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{long, "foo"}, actual)
+	})
+
+	t.Run("Split", func(t *testing.T) {
+		_, err := ToSliceE(Split(FromReadSeeker(strings.NewReader(src)), bufio.ScanLines))
+
+		assert.ErrorIs(t, err, bufio.ErrTooLong)
+	})
+
+	t.Run("SplitWith", func(t *testing.T) {
+		gen := SplitWith(FromReadSeeker(strings.NewReader(src)), bufio.ScanLines, SplitOptions{MaxTokenSize: 1 << 20})
+		actual, err := ToSliceE(gen)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(long), []byte("foo")}, actual)
+	})
+}
+
+func TestOpenFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "log.txt")
+	assert.NoError(t, os.WriteFile(name, []byte("INFO start\nERROR failed\nINFO end\n"), 0o600))
+
+	isError := UniE(func(line string) (bool, error) {
+		return strings.HasPrefix(line, "ERROR"), nil
+	})
+	lines := Lines(OpenFile(name))
+	gen := WhereE(lines, isError(lines))
+
+	// generators over files can be restarted
+	for range 2 {
+		actual, err := ToSliceE(gen)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ERROR failed"}, actual)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	t.Run("open", func(t *testing.T) {
+		_, err := ToSliceE(Lines(OpenFile(filepath.Join(t.TempDir(), "missing"))))
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	tests := []struct {
+		name string
+		err  func(Opener) error
+	}{
+		{"Lines", func(o Opener) error { return errOf(Lines(o)) }},
+		{"Runes", func(o Opener) error { return errOf(Runes(o)) }},
+		{"Bytes", func(o Opener) error { return errOf(Bytes(o, 4)) }},
+		{"Split", func(o Opener) error { return errOf(Split(o, bufio.ScanWords)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err(failingOpener(iotest.ErrReader(errTest)))
+
+			assert.ErrorIs(t, err, errTest)
+		})
+	}
+}
+
+func TestReaderClosedOnEarlyExit(t *testing.T) {
+	tests := []struct {
+		name    string
+		iterate func(Opener)
+	}{
+		{"Lines", func(o Opener) { breakFirst(Lines(o)) }},
+		{"Runes", func(o Opener) { breakFirst(Runes(o)) }},
+		{"Bytes", func(o Opener) { breakFirst(Bytes(o, 1)) }},
+		{"Split", func(o Opener) { breakFirst(Split(o, bufio.ScanWords)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, count := tracked("foo\nbar\n")
+			tt.iterate(open)
+
+			assert.Equal(t, 0, *count)
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package itermania
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Fold returns a generator of the result of folding values of gen with f from init.
+//
+// The result is init if gen is empty.
+func Fold[V, W any](gen Gen[V], init W, f func(W, V) W) Gen[W] {
+	return lazy(func() (W, bool) {
+		return FoldValue(gen, init, f), true
+	})
+}
+
+// Reduce returns a generator of the result of folding values of gen with f from the first value.
+//
+// The result generates nothing if gen is empty.
+func Reduce[V any](gen Gen[V], f func(V, V) V) Gen[V] {
+	return lazy(func() (V, bool) {
+		return ReduceValue(gen, f)
+	})
+}
+
+// Sum returns a generator of the sum of values in gen, which is 0 if gen is empty.
+func Sum[V Number](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return SumValue(gen), true
+	})
+}
+
+// Product returns a generator of the product of values in gen, which is 1 if gen is empty.
+func Product[V Number](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return ProductValue(gen), true
+	})
+}
+
+// Count returns a generator of the number of values in gen.
+func Count[V any](gen Gen[V]) Gen[int] {
+	return lazy(func() (int, bool) {
+		return CountValue(gen), true
+	})
+}
+
+// Min returns a generator of the minimum value in gen.
+//
+// The result generates nothing if gen is empty.
+func Min[V constraints.Ordered](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return MinValue(gen)
+	})
+}
+
+// Max returns a generator of the maximum value in gen.
+//
+// The result generates nothing if gen is empty.
+func Max[V constraints.Ordered](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return MaxValue(gen)
+	})
+}
+
+// MinBy returns a generator of the value in gen with the minimum key.
+//
+// The first one is chosen if some values have the same key. The result generates nothing if gen is empty.
+func MinBy[V any, K constraints.Ordered](gen Gen[V], key func(V) K) Gen[V] {
+	return lazy(func() (V, bool) {
+		return MinByValue(gen, key)
+	})
+}
+
+// MaxBy returns a generator of the value in gen with the maximum key.
+//
+// The first one is chosen if some values have the same key. The result generates nothing if gen is empty.
+func MaxBy[V any, K constraints.Ordered](gen Gen[V], key func(V) K) Gen[V] {
+	return lazy(func() (V, bool) {
+		return MaxByValue(gen, key)
+	})
+}
+
+// Mean returns a generator of the arithmetic mean of values in gen.
+//
+// The result generates nothing if gen is empty.
+func Mean[V Number](gen Gen[V]) Gen[float64] {
+	return lazy(func() (float64, bool) {
+		return MeanValue(gen)
+	})
+}
+
+// First returns a generator of the first value in gen.
+//
+// The result generates nothing if gen is empty.
+func First[V any](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return FirstValue(gen)
+	})
+}
+
+// Last returns a generator of the last value in gen.
+//
+// The result generates nothing if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func Last[V any](gen Gen[V]) Gen[V] {
+	return lazy(func() (V, bool) {
+		return LastValue(gen)
+	})
+}
+
+// Nth returns a generator of the n-th (0-indexed) value in gen.
+//
+// The result generates nothing if gen has n or fewer values.
+func Nth[V any](gen Gen[V], n int) Gen[V] {
+	return lazy(func() (V, bool) {
+		return NthValue(gen, n)
+	})
+}
+
+// FoldValue folds values of gen with f from init.
+//
+// Caution: This hangs up if the iteration is infinite.
+func FoldValue[V, W any](gen Gen[V], init W, f func(W, V) W) W {
+	acc := init
+	for v := range gen() {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// ReduceValue folds values of gen with f from the first value.
+//
+// It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func ReduceValue[V any](gen Gen[V], f func(V, V) V) (V, bool) {
+	var acc V
+	found := false
+	for v := range gen() {
+		if !found {
+			acc = v
+			found = true
+			continue
+		}
+		acc = f(acc, v)
+	}
+	return acc, found
+}
+
+// SumValue returns the sum of values in gen, which is 0 if gen is empty.
+//
+// Caution: This hangs up if the iteration is infinite.
+func SumValue[V Number](gen Gen[V]) V {
+	return FoldValue(gen, 0, func(acc V, v V) V { return acc + v })
+}
+
+// ProductValue returns the product of values in gen, which is 1 if gen is empty.
+//
+// Caution: This hangs up if the iteration is infinite.
+func ProductValue[V Number](gen Gen[V]) V {
+	return FoldValue(gen, 1, func(acc V, v V) V { return acc * v })
+}
+
+// CountValue returns the number of values in gen.
+//
+// Caution: This hangs up if the iteration is infinite.
+func CountValue[V any](gen Gen[V]) int {
+	return FoldValue(gen, 0, func(acc int, _ V) int { return acc + 1 })
+}
+
+// MinValue returns the minimum value in gen.
+//
+// It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func MinValue[V constraints.Ordered](gen Gen[V]) (V, bool) {
+	return ReduceValue(gen, func(acc V, v V) V { return min(acc, v) })
+}
+
+// MaxValue returns the maximum value in gen.
+//
+// It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func MaxValue[V constraints.Ordered](gen Gen[V]) (V, bool) {
+	return ReduceValue(gen, func(acc V, v V) V { return max(acc, v) })
+}
+
+// MinByValue returns the value in gen with the minimum key.
+//
+// The first one is chosen if some values have the same key. It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func MinByValue[V any, K constraints.Ordered](gen Gen[V], key func(V) K) (V, bool) {
+	return ReduceValue(gen, func(acc V, v V) V {
+		if key(v) < key(acc) {
+			return v
+		}
+		return acc
+	})
+}
+
+// MaxByValue returns the value in gen with the maximum key.
+//
+// The first one is chosen if some values have the same key. It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func MaxByValue[V any, K constraints.Ordered](gen Gen[V], key func(V) K) (V, bool) {
+	return ReduceValue(gen, func(acc V, v V) V {
+		if key(v) > key(acc) {
+			return v
+		}
+		return acc
+	})
+}
+
+// MeanValue returns the arithmetic mean of values in gen.
+//
+// It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func MeanValue[V Number](gen Gen[V]) (float64, bool) {
+	sum := 0.0
+	n := 0
+	for v := range gen() {
+		sum += float64(v)
+		n++
+	}
+
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
+
+// FirstValue returns the first value in gen.
+//
+// It returns false if gen is empty.
+func FirstValue[V any](gen Gen[V]) (V, bool) {
+	return NthValue(gen, 0)
+}
+
+// LastValue returns the last value in gen.
+//
+// It returns false if gen is empty.
+// Caution: This hangs up if the iteration is infinite.
+func LastValue[V any](gen Gen[V]) (V, bool) {
+	return ReduceValue(gen, func(_ V, v V) V { return v })
+}
+
+// NthValue returns the n-th (0-indexed) value in gen.
+//
+// It returns false if gen has n or fewer values.
+func NthValue[V any](gen Gen[V], n int) (V, bool) {
+	var zero V
+	if n < 0 {
+		return zero, false
+	}
+
+	i := 0
+	for v := range gen() {
+		if i == n {
+			return v, true
+		}
+		i++
+	}
+	return zero, false
+}
+
+// lazy returns a generator of the result of f, which is evaluated on each iteration.
+// Nothing is generated if f returns false.
+func lazy[V any](f func() (V, bool)) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			v, ok := f()
+			if !ok {
+				return
+			}
+			yield(v)
+		}
+	}
+}
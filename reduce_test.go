@@ -0,0 +1,300 @@
+package itermania
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	join := func(acc string, v int) string {
+		return acc + strings.Repeat("*", v)
+	}
+
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected string
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			">",
+		},
+		{
+			"multiple values",
+			Range(1, 4, 1),
+			">******",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FoldValue(tt.gen, ">", join))
+			assert.Equal(t, []string{tt.expected}, ToSlice(Fold(tt.gen, ">", join)))
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sub := func(acc int, v int) int { return acc - v }
+
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]int{},
+		},
+		{
+			"one value",
+			Const(3),
+			[]int{3},
+		},
+		{
+			"multiple values",
+			FromSlice([]int{10, 1, 2}),
+			[]int{7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := ReduceValue(tt.gen, sub)
+			assert.Equal(t, len(tt.expected) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expected[0], v)
+			}
+
+			assert.Equal(t, tt.expected, ToSlice(Reduce(tt.gen, sub)))
+		})
+	}
+}
+
+func TestSumProductCount(t *testing.T) {
+	tests := []struct {
+		name            string
+		gen             Gen[int]
+		expectedSum     int
+		expectedProduct int
+		expectedCount   int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			0,
+			1,
+			0,
+		},
+		{
+			"multiple values",
+			Range(1, 5, 1),
+			10,
+			24,
+			4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedSum, SumValue(tt.gen))
+			assert.Equal(t, []int{tt.expectedSum}, ToSlice(Sum(tt.gen)))
+
+			assert.Equal(t, tt.expectedProduct, ProductValue(tt.gen))
+			assert.Equal(t, []int{tt.expectedProduct}, ToSlice(Product(tt.gen)))
+
+			assert.Equal(t, tt.expectedCount, CountValue(tt.gen))
+			assert.Equal(t, []int{tt.expectedCount}, ToSlice(Count(tt.gen)))
+		})
+	}
+}
+
+func TestSumFloat(t *testing.T) {
+	assert.Equal(t, 4.0, SumValue(FromSlice([]float64{1.5, 2.5})))
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         Gen[int]
+		expectedMin []int
+		expectedMax []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]int{},
+			[]int{},
+		},
+		{
+			"multiple values",
+			FromSlice([]int{3, 1, 4, 1, 5}),
+			[]int{1},
+			[]int{5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMin, ToSlice(Min(tt.gen)))
+			assert.Equal(t, tt.expectedMax, ToSlice(Max(tt.gen)))
+
+			minV, ok := MinValue(tt.gen)
+			assert.Equal(t, len(tt.expectedMin) > 0, ok)
+			maxV, ok := MaxValue(tt.gen)
+			assert.Equal(t, len(tt.expectedMax) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expectedMin[0], minV)
+				assert.Equal(t, tt.expectedMax[0], maxV)
+			}
+		})
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         Gen[string]
+		expectedMin []string
+		expectedMax []string
+	}{
+		{
+			"empty",
+			FromSlice([]string{}),
+			[]string{},
+			[]string{},
+		},
+		{
+			"first one is chosen",
+			FromSlice([]string{"bb", "a", "c", "dd"}),
+			[]string{"a"},
+			[]string{"bb"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			length := func(s string) int { return len(s) }
+
+			assert.Equal(t, tt.expectedMin, ToSlice(MinBy(tt.gen, length)))
+			assert.Equal(t, tt.expectedMax, ToSlice(MaxBy(tt.gen, length)))
+
+			minV, ok := MinByValue(tt.gen, length)
+			assert.Equal(t, len(tt.expectedMin) > 0, ok)
+			maxV, ok := MaxByValue(tt.gen, length)
+			assert.Equal(t, len(tt.expectedMax) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expectedMin[0], minV)
+				assert.Equal(t, tt.expectedMax[0], maxV)
+			}
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []float64
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]float64{},
+		},
+		{
+			"multiple values",
+			FromSlice([]int{1, 2, 4}),
+			[]float64{7.0 / 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToSlice(Mean(tt.gen)))
+
+			v, ok := MeanValue(tt.gen)
+			assert.Equal(t, len(tt.expected) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expected[0], v)
+			}
+		})
+	}
+}
+
+func TestFirstLastNth(t *testing.T) {
+	tests := []struct {
+		name          string
+		gen           Gen[int]
+		expectedFirst []int
+		expectedLast  []int
+		expectedNth   []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]int{},
+			[]int{},
+			[]int{},
+		},
+		{
+			"shorter than n",
+			FromSlice([]int{1, 2}),
+			[]int{1},
+			[]int{2},
+			[]int{},
+		},
+		{
+			"multiple values",
+			FromSlice([]int{1, 2, 3, 4}),
+			[]int{1},
+			[]int{4},
+			[]int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedFirst, ToSlice(First(tt.gen)))
+			assert.Equal(t, tt.expectedLast, ToSlice(Last(tt.gen)))
+			assert.Equal(t, tt.expectedNth, ToSlice(Nth(tt.gen, 2)))
+
+			first, ok := FirstValue(tt.gen)
+			assert.Equal(t, len(tt.expectedFirst) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expectedFirst[0], first)
+			}
+
+			last, ok := LastValue(tt.gen)
+			assert.Equal(t, len(tt.expectedLast) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expectedLast[0], last)
+			}
+
+			nth, ok := NthValue(tt.gen, 2)
+			assert.Equal(t, len(tt.expectedNth) > 0, ok)
+			if ok {
+				assert.Equal(t, tt.expectedNth[0], nth)
+			}
+		})
+	}
+}
+
+func TestFirstNthInfinite(t *testing.T) {
+	assert.Equal(t, []int{10}, ToSlice(First(Inc(10))))
+	assert.Equal(t, []int{13}, ToSlice(Nth(Inc(10), 3)))
+	assert.Equal(t, []int{}, ToSlice(Nth(Inc(10), -1)))
+}
+
+func TestReducersInBind(t *testing.T) {
+	// triangular numbers
+	gen := Bind(Range(1, 6, 1), func(n int) Gen[int] {
+		return Sum(Range(1, n+1, 1))
+	})
+
+	assert.Equal(t, []int{1, 3, 6, 10, 15}, ToSlice(gen))
+}
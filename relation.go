@@ -0,0 +1,177 @@
+package itermania
+
+import "iter"
+
+// GroupBy returns a generator that iterates keys and values of gen grouped by key.
+//
+// Groups are iterated in order of the first appearance of their keys,
+// and values in each group keep their order in gen.
+// Since all values are read before the first group is iterated, gen must be finite.
+// Use GroupBySorted if values with the same key are adjacent in gen.
+func GroupBy[V any, K comparable](gen Gen[V], key func(V) K) Gen2[K, []V] {
+	return func() iter.Seq2[K, []V] {
+		return func(yield func(K, []V) bool) {
+			keys := []K{}
+			groups := map[K][]V{}
+			for v := range gen() {
+				k := key(v)
+				if _, ok := groups[k]; !ok {
+					keys = append(keys, k)
+				}
+				groups[k] = append(groups[k], v)
+			}
+
+			for _, k := range keys {
+				if !yield(k, groups[k]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GroupBySorted returns a generator that iterates keys and runs of adjacent values in gen with the same key.
+//
+// Unlike GroupBy, each group is iterated as soon as it ends, so gen can be infinite.
+// A key appears more than once if its values are not adjacent.
+func GroupBySorted[V any, K comparable](gen Gen[V], key func(V) K) Gen2[K, []V] {
+	return func() iter.Seq2[K, []V] {
+		return func(yield func(K, []V) bool) {
+			var current K
+			group := []V{}
+			for v := range gen() {
+				k := key(v)
+				if len(group) > 0 && k != current {
+					if !yield(current, group) {
+						return
+					}
+					group = []V{}
+				}
+				current = k
+				group = append(group, v)
+			}
+
+			if len(group) > 0 {
+				yield(current, group)
+			}
+		}
+	}
+}
+
+// CountBy returns a generator that iterates keys and the numbers of values in gen with the key.
+//
+// Keys are iterated in order of their first appearance. gen must be finite.
+func CountBy[V any, K comparable](gen Gen[V], key func(V) K) Gen2[K, int] {
+	return func() iter.Seq2[K, int] {
+		return func(yield func(K, int) bool) {
+			for k, group := range GroupBy(gen, key)() {
+				if !yield(k, len(group)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Distinct returns a generator that iterates values of gen skipping ones which have already appeared.
+//
+// It works on infinite generators, though all distinct values are kept in memory.
+func Distinct[V comparable](gen Gen[V]) Gen[V] {
+	return DistinctBy(gen, func(v V) V { return v })
+}
+
+// DistinctBy returns a generator that iterates values of gen skipping ones whose keys have already appeared.
+func DistinctBy[V any, K comparable](gen Gen[V], key func(V) K) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			seen := map[K]struct{}{}
+			for v := range gen() {
+				k := key(v)
+				if _, ok := seen[k]; ok {
+					continue
+				}
+				seen[k] = struct{}{}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Join returns a generator that iterates pairs of values in left and right which have the same key.
+//
+// It is a hash join: right is read into a hash table once per invocation, and then left is iterated
+// in order, so right must be finite while left can be infinite.
+// Matched values of right are iterated in their order in right.
+func Join[L, R any, K comparable](left Gen[L], right Gen[R], lKey func(L) K, rKey func(R) K) Gen2[L, R] {
+	return func() iter.Seq2[L, R] {
+		return func(yield func(L, R) bool) {
+			table := hashTable(right, rKey)
+			for l := range left() {
+				for _, r := range table[lKey(l)] {
+					if !yield(l, r) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// LeftJoin returns a generator that iterates pairs of values in left and right which have the same key,
+// in the same way as Join.
+//
+// Values of left without matches are also iterated, paired with nil.
+func LeftJoin[L, R any, K comparable](left Gen[L], right Gen[R], lKey func(L) K, rKey func(R) K) Gen2[L, *R] {
+	return func() iter.Seq2[L, *R] {
+		return func(yield func(L, *R) bool) {
+			table := hashTable(right, rKey)
+			for l := range left() {
+				rs := table[lKey(l)]
+				if len(rs) == 0 {
+					if !yield(l, nil) {
+						return
+					}
+					continue
+				}
+
+				for _, r := range rs {
+					if !yield(l, &r) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// SemiJoin returns a generator that iterates values of left which have at least one value in right with the same key.
+//
+// Each value of left is iterated at most once. right must be finite while left can be infinite.
+func SemiJoin[L, R any, K comparable](left Gen[L], right Gen[R], lKey func(L) K, rKey func(R) K) Gen[L] {
+	return func() iter.Seq[L] {
+		return func(yield func(L) bool) {
+			table := hashTable(right, rKey)
+			for l := range left() {
+				if _, ok := table[lKey(l)]; !ok {
+					continue
+				}
+
+				if !yield(l) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func hashTable[V any, K comparable](gen Gen[V], key func(V) K) map[K][]V {
+	table := map[K][]V{}
+	for v := range gen() {
+		k := key(v)
+		table[k] = append(table[k], v)
+	}
+	return table
+}
@@ -0,0 +1,202 @@
+package itermania
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func firstLetter(s string) byte {
+	return s[0]
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[string]
+		expected []Pair[byte, []string]
+	}{
+		{
+			"empty",
+			FromSlice([]string{}),
+			[]Pair[byte, []string]{},
+		},
+		{
+			"unsorted",
+			FromSlice([]string{"apple", "banana", "avocado", "cherry", "blueberry"}),
+			[]Pair[byte, []string]{
+				{'a', []string{"apple", "avocado"}},
+				{'b', []string{"banana", "blueberry"}},
+				{'c', []string{"cherry"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(GroupBy(tt.gen, firstLetter))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGroupBySorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[string]
+		expected []Pair[byte, []string]
+	}{
+		{
+			"empty",
+			FromSlice([]string{}),
+			[]Pair[byte, []string]{},
+		},
+		{
+			"sorted",
+			FromSlice([]string{"apple", "avocado", "banana", "cherry"}),
+			[]Pair[byte, []string]{
+				{'a', []string{"apple", "avocado"}},
+				{'b', []string{"banana"}},
+				{'c', []string{"cherry"}},
+			},
+		},
+		{
+			"not adjacent",
+			FromSlice([]string{"apple", "banana", "avocado"}),
+			[]Pair[byte, []string]{
+				{'a', []string{"apple"}},
+				{'b', []string{"banana"}},
+				{'a', []string{"avocado"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toPairs(GroupBySorted(tt.gen, firstLetter))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGroupBySortedInfinite(t *testing.T) {
+	gen := GroupBySorted(Inc(0), func(i int) int { return i / 3 })
+	actual := toPairs(Head2(gen, 2))
+
+	assert.Equal(t, []Pair[int, []int]{{0, []int{0, 1, 2}}, {1, []int{3, 4, 5}}}, actual)
+}
+
+func TestCountBy(t *testing.T) {
+	gen := CountBy(FromSlice([]string{"apple", "banana", "avocado", "apricot"}), firstLetter)
+	actual := toPairs(gen)
+
+	assert.Equal(t, []Pair[byte, int]{{'a', 3}, {'b', 1}}, actual)
+}
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]int{},
+		},
+		{
+			"duplicated",
+			FromSlice([]int{3, 1, 3, 2, 1}),
+			[]int{3, 1, 2},
+		},
+		{
+			"infinite",
+			Head(Distinct(Mod(Inc(0), Const(5))), 5),
+			[]int{0, 1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Distinct(tt.gen))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	gen := DistinctBy(FromSlice([]string{"Foo", "bar", "foo", "BAR", "baz"}), strings.ToLower)
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []string{"Foo", "bar", "baz"}, actual)
+}
+
+type order struct {
+	ID     int
+	UserID int
+}
+
+type customer struct {
+	ID   int
+	Name string
+}
+
+func orderUserID(o order) int {
+	return o.UserID
+}
+
+func customerID(c customer) int {
+	return c.ID
+}
+
+var (
+	orders    = FromSlice([]order{{1, 10}, {2, 20}, {3, 10}, {4, 30}})
+	customers = FromSlice([]customer{{10, "alice"}, {20, "bob"}, {20, "bob2"}})
+)
+
+func TestJoin(t *testing.T) {
+	actual := toPairs(Join(orders, customers, orderUserID, customerID))
+
+	assert.Equal(t, []Pair[order, customer]{
+		{order{1, 10}, customer{10, "alice"}},
+		{order{2, 20}, customer{20, "bob"}},
+		{order{2, 20}, customer{20, "bob2"}},
+		{order{3, 10}, customer{10, "alice"}},
+	}, actual)
+}
+
+func TestJoinInfiniteLeft(t *testing.T) {
+	gen := Join(Inc(0), FromSlice([]string{"b", "d"}), func(i int) int { return i % 5 }, func(s string) int { return int(s[0] - 'a') })
+	actual := toPairs(Head2(gen, 3))
+
+	assert.Equal(t, []Pair[int, string]{{1, "b"}, {3, "d"}, {6, "b"}}, actual)
+}
+
+func TestLeftJoin(t *testing.T) {
+	actual := []Pair[order, string]{}
+	for o, c := range LeftJoin(orders, customers, orderUserID, customerID)() {
+		name := "<nil>"
+		if c != nil {
+			name = c.Name
+		}
+		actual = append(actual, Pair[order, string]{o, name})
+	}
+
+	assert.Equal(t, []Pair[order, string]{
+		{order{1, 10}, "alice"},
+		{order{2, 20}, "bob"},
+		{order{2, 20}, "bob2"},
+		{order{3, 10}, "alice"},
+		{order{4, 30}, "<nil>"},
+	}, actual)
+}
+
+func TestSemiJoin(t *testing.T) {
+	actual := ToSlice(SemiJoin(orders, customers, orderUserID, customerID))
+
+	assert.Equal(t, []order{{1, 10}, {2, 20}, {3, 10}}, actual)
+}
@@ -0,0 +1,154 @@
+package itermania
+
+import (
+	"cmp"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// restartable returns a test which checks that every invocation of gen
+// generates the same first n values.
+func restartable[V any](gen Gen[V], n int) func(t *testing.T) {
+	return func(t *testing.T) {
+		expected := ToSlice(Head(gen, n))
+
+		t.Run("invoke again", func(t *testing.T) {
+			assert.Equal(t, expected, ToSlice(Head(gen, n)))
+		})
+
+		t.Run("invoke again after early exit", func(t *testing.T) {
+			for range gen() {
+				break
+			}
+			assert.Equal(t, expected, ToSlice(Head(gen, n)))
+		})
+
+		t.Run("iterate the same seq twice", func(t *testing.T) {
+			seq := gen()
+			first := []V{}
+			for v := range seq {
+				if len(first) >= n {
+					break
+				}
+				first = append(first, v)
+			}
+			second := []V{}
+			for v := range seq {
+				if len(second) >= n {
+					break
+				}
+				second = append(second, v)
+			}
+
+			assert.Equal(t, expected, first)
+			assert.Equal(t, expected, second)
+		})
+
+		t.Run("invoke concurrently", func(t *testing.T) {
+			const goroutines = 8
+			results := make([][]V, goroutines)
+
+			var wg sync.WaitGroup
+			for i := range goroutines {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					results[i] = ToSlice(Head(gen, n))
+				}()
+			}
+			wg.Wait()
+
+			for _, actual := range results {
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func TestRestartable(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{"Const", restartable(Const(1), 3)},
+		{"Head", restartable(Head(Inc(0), 3), 5)},
+		{"Inc", restartable(Inc(0), 5)},
+		{"Dec", restartable(Dec(0), 5)},
+		{"Range", restartable(Range(0, 10, 2), 10)},
+		{"Concat", restartable(Concat(Range(0, 2, 1), Inc(10)), 5)},
+		{"Where", restartable(Where(Inc(0), Loop(true)), 5)},
+		{"Bind", restartable(Bind(Inc(0), func(i int) Gen[int] { return Range(0, i, 1) }), 10)},
+		{"If", restartable(If(FromSlice([]bool{true, false, true}), Inc(0), Dec(0)), 5)},
+		{"LazyIf", restartable(LazyIf(FromSlice([]bool{true, false, true}), Inc(0), Dec(0)), 5)},
+		{"All", restartable(All(FromSlice([]bool{true, true})), 3)},
+		{"Any", restartable(Any(FromSlice([]bool{false, true})), 3)},
+		{"Loop", restartable(Loop("foo"), 5)},
+		{"Repeat", restartable(Repeat(Range(0, 3, 1)), 10)},
+		{"Limit", restartable(Limit(Inc(0), Range(1, 4, 1)), 10)},
+		{"Bin", restartable(Add(Range(0, 3, 1), Head(Inc(10), 2)), 10)},
+		{"Uni", restartable(Not(FromSlice([]bool{true, false})), 3)},
+		{"Zip", restartable(Zip(Inc(0), Dec(0)), 5)},
+		{"ZipLongestWith", restartable(ZipLongestWith(func(x, y int) int { return x + y }, 0, 0)(Range(0, 3, 1), Inc(0)), 5)},
+		{"Enumerate", restartable(Keys(Enumerate(Inc(5))), 5)},
+		{"Zip2", restartable(Values(Zip2(Inc(0), Dec(0))), 5)},
+		{"Memo", restartable(Memo(Inc(0)), 5)},
+		{"MemoWith", restartable(MemoWith(Inc(0), MemoOptions{MaxSize: 2}), 5)},
+		{"RunningSum", restartable(RunningSum(Inc(0)), 5)},
+		{"RunningMax", restartable(RunningMax(Inc(0)), 5)},
+		{"Chunk", restartable(Chunk(Inc(0), 2), 3)},
+		{"Window", restartable(Window(Inc(0), 3, 2), 3)},
+		{"Pairwise", restartable(Pairwise(Inc(0)), 3)},
+		{"Filter", restartable(Filter(Inc(0), isEven), 5)},
+		{"TakeWhile", restartable(TakeWhile(Inc(0), lessThan(3)), 5)},
+		{"DropWhile", restartable(DropWhile(Inc(0), lessThan(3)), 5)},
+		{"TakeUntil", restartable(TakeUntil(Inc(0), isEven), 5)},
+		{"Skip", restartable(Skip(Inc(0), 2), 5)},
+		{"Step", restartable(Step(Inc(0), 3), 5)},
+		{"DropWhileGen", restartable(DropWhileGen(Inc(0), FromSlice([]bool{true, false})), 5)},
+		{"GroupBySorted", restartable(Keys(GroupBySorted(Inc(0), func(i int) int { return i / 2 })), 5)},
+		{"Distinct", restartable(Distinct(Inc(0)), 5)},
+		{"Join", restartable(Keys(Join(Inc(0), Range(0, 3, 1), func(i int) int { return i % 4 }, func(i int) int { return i })), 5)},
+		{"SemiJoin", restartable(SemiJoin(Inc(0), Range(0, 3, 1), func(i int) int { return i % 4 }, func(i int) int { return i }), 5)},
+		{"Sorted", restartable(Sorted(FromSlice([]int{3, 1, 2}), cmp.Compare[int]), 5)},
+		{"TopK", restartable(TopK(Range(0, 10, 1), 3, cmp.Compare[int]), 5)},
+		{"MergeSorted", restartable(MergeSorted(cmp.Compare[int], Inc(0), Inc(0)), 5)},
+		{"FromSlice", restartable(FromSlice([]int{1, 2, 3}), 5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.test)
+	}
+}
+
+func TestBinRestartsRightOperand(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        Gen[int]
+		y        Gen[int]
+		expected []int
+	}{
+		{
+			"Inc",
+			FromSlice([]int{0, 10}),
+			Head(Inc(0), 2),
+			[]int{0, 1, 10, 11},
+		},
+		{
+			"Dec",
+			FromSlice([]int{0, 10}),
+			Head(Dec(0), 2),
+			[]int{0, -1, 10, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Add(tt.x, tt.y)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
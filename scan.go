@@ -0,0 +1,71 @@
+package itermania
+
+import "iter"
+
+// Scan returns a generator of intermediate results of folding values of gen with f from init.
+//
+// The result yields the accumulator after each value of gen, excluding init itself.
+func Scan[V, W any](gen Gen[V], init W, f func(W, V) W) Gen[W] {
+	return func() iter.Seq[W] {
+		return func(yield func(W) bool) {
+			acc := init
+			for v := range gen() {
+				acc = f(acc, v)
+				if !yield(acc) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RunningSum returns a generator of cumulative sums of values in gen.
+func RunningSum[V Number](gen Gen[V]) Gen[V] {
+	return Scan(gen, 0, func(acc V, v V) V { return acc + v })
+}
+
+// RunningMax returns a generator of maximum values of gen so far.
+func RunningMax[V Number](gen Gen[V]) Gen[V] {
+	return scan1(gen, func(acc V, v V) V { return max(acc, v) })
+}
+
+// RunningMin returns a generator of minimum values of gen so far.
+func RunningMin[V Number](gen Gen[V]) Gen[V] {
+	return scan1(gen, func(acc V, v V) V { return min(acc, v) })
+}
+
+// ExponentialMovingAverage returns a generator of exponential moving averages of values in gen.
+//
+// The first average is the first value, and then each average is alpha*v + (1-alpha)*previous.
+// It panics if alpha is not in (0, 1].
+func ExponentialMovingAverage[V Number](gen Gen[V], alpha float64) Gen[float64] {
+	if !(0 < alpha && alpha <= 1) {
+		panic("itermania: alpha of ExponentialMovingAverage must be in (0, 1]")
+	}
+
+	return scan1(Uni(func(v V) float64 { return float64(v) })(gen), func(acc float64, v float64) float64 {
+		return alpha*v + (1-alpha)*acc
+	})
+}
+
+// scan1 works as Scan but uses the first value of gen as the initial accumulator.
+func scan1[V any](gen Gen[V], f func(V, V) V) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			var acc V
+			first := true
+			for v := range gen() {
+				if first {
+					acc = v
+					first = false
+				} else {
+					acc = f(acc, v)
+				}
+
+				if !yield(acc) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package itermania
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []string
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]string{},
+		},
+		{
+			"multiple values",
+			Range(1, 4, 1),
+			[]string{">1", ">12", ">123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Scan(tt.gen, ">", func(acc string, v int) string {
+				return acc + string(rune('0'+v))
+			})
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRunning(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(Gen[int]) Gen[int]
+		gen      Gen[int]
+		expected []int
+	}{
+		{
+			"sum",
+			RunningSum[int],
+			FromSlice([]int{1, 2, 3, 4}),
+			[]int{1, 3, 6, 10},
+		},
+		{
+			"max",
+			RunningMax[int],
+			FromSlice([]int{3, 1, 4, 1, 5}),
+			[]int{3, 3, 4, 4, 5},
+		},
+		{
+			"min",
+			RunningMin[int],
+			FromSlice([]int{3, 1, 4, 0, 5}),
+			[]int{3, 1, 1, 0, 0},
+		},
+		{
+			"empty sum",
+			RunningSum[int],
+			FromSlice([]int{}),
+			[]int{},
+		},
+		{
+			"empty max",
+			RunningMax[int],
+			FromSlice([]int{}),
+			[]int{},
+		},
+		{
+			"infinite sum",
+			RunningSum[int],
+			Inc(1),
+			[]int{1, 3, 6, 10, 15},
+		},
+		{
+			"infinite max",
+			RunningMax[int],
+			Dec(0),
+			[]int{0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Head(tt.f(tt.gen), 5)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		alpha    float64
+		expected []float64
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			0.5,
+			[]float64{},
+		},
+		{
+			"half",
+			FromSlice([]int{4, 8, 0}),
+			0.5,
+			[]float64{4, 6, 3},
+		},
+		{
+			"alpha is one",
+			FromSlice([]int{4, 8, 0}),
+			1,
+			[]float64{4, 8, 0},
+		},
+		{
+			"infinite",
+			Loop(2),
+			0.25,
+			[]float64{2, 2, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Head(ExponentialMovingAverage(tt.gen, tt.alpha), 3)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestExponentialMovingAverageInvalidAlpha(t *testing.T) {
+	for _, alpha := range []float64{0, -0.5, 1.5} {
+		assert.Panics(t, func() {
+			ExponentialMovingAverage(Inc(0), alpha)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package itermania
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"slices"
+)
+
+// Sorted returns a generator that iterates values of gen sorted by cmp.
+//
+// The sort is stable. Since all values are read before the first one is iterated, gen must be finite.
+// Use ExternalSorted if values of gen do not fit in memory.
+func Sorted[V any](gen Gen[V], cmp func(V, V) int) Gen[V] {
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			values := ToSlice(gen)
+			slices.SortStableFunc(values, cmp)
+
+			for _, v := range values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TopK returns a generator that iterates the k largest values of gen by cmp in descending order.
+//
+// Only k values are held at a time, so long streams can be processed with bounded memory.
+// The order of equal values is unspecified. gen must be finite.
+// It panics if k is negative.
+func TopK[V any](gen Gen[V], k int, cmp func(V, V) int) Gen[V] {
+	if k < 0 {
+		panic("itermania: k of TopK must not be negative")
+	}
+
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			if k == 0 {
+				return
+			}
+
+			// min-heap whose root is the smallest value among the top k
+			h := &valueHeap[V]{less: func(x, y V) bool { return cmp(x, y) < 0 }}
+			for v := range gen() {
+				if h.Len() < k {
+					heap.Push(h, v)
+					continue
+				}
+				if cmp(v, h.values[0]) > 0 {
+					h.values[0] = v
+					heap.Fix(h, 0)
+				}
+			}
+
+			top := make([]V, h.Len())
+			for i := len(top) - 1; i >= 0; i-- {
+				top[i] = heap.Pop(h).(V)
+			}
+
+			for _, v := range top {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// MergeSorted returns a generator that iterates values of gens, each of which must be sorted by cmp, in sorted order.
+//
+// Equal values are iterated in order of gens. Only one value of each generator is held at a time,
+// so gens can be infinite.
+func MergeSorted[V any](cmp func(V, V) int, gens ...Gen[V]) Gen[V] {
+	gensE := make([]GenE[V], len(gens))
+	for i, gen := range gens {
+		gensE[i] = Lift(gen)
+	}
+	merged := mergeSortedE(cmp, gensE...)
+
+	return func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range merged() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// SortOptions configures ExternalSorted.
+type SortOptions struct {
+	// MaxInMemory is the maximum number of values sorted in memory at a time.
+	MaxInMemory int
+	// TempDir is the directory where sorted runs are spilled. os.TempDir is used if empty.
+	TempDir string
+}
+
+// ExternalSorted returns an error-aware generator that iterates values of gen sorted by cmp.
+//
+// Values are sorted in memory by opts.MaxInMemory at a time, and each sorted run is spilled into a temporary file
+// encoded by encoding/gob, so V must be encodable by gob. The runs are then merged lazily.
+// If gen has no more values than opts.MaxInMemory, no files are created.
+// The sort is stable, and temporary files are removed when the iteration finishes, fails or is stopped early.
+// It panics if opts.MaxInMemory is not positive.
+func ExternalSorted[V any](gen Gen[V], cmp func(V, V) int, opts SortOptions) GenE[V] {
+	if opts.MaxInMemory <= 0 {
+		panic("itermania: MaxInMemory of SortOptions must be positive")
+	}
+
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			var zero V
+
+			runs := []string{}
+			defer func() {
+				for _, name := range runs {
+					os.Remove(name)
+				}
+			}()
+
+			buf := make([]V, 0, opts.MaxInMemory)
+			for v := range gen() {
+				// spill only when values exceed the budget
+				if len(buf) == opts.MaxInMemory {
+					slices.SortStableFunc(buf, cmp)
+					name, err := spill(buf, opts.TempDir)
+					if name != "" {
+						runs = append(runs, name)
+					}
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+					buf = buf[:0]
+				}
+				buf = append(buf, v)
+			}
+			slices.SortStableFunc(buf, cmp)
+
+			gens := make([]GenE[V], 0, len(runs)+1)
+			for _, name := range runs {
+				gens = append(gens, readRun[V](name))
+			}
+			gens = append(gens, Lift(FromSlice(buf)))
+
+			for v, err := range mergeSortedE(cmp, gens...)() {
+				if !yield(v, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// spill writes values into a new temporary file in dir and returns its name.
+func spill[V any](values []V, dir string) (string, error) {
+	f, err := os.CreateTemp(dir, "itermania-sort-*")
+	if err != nil {
+		return "", err
+	}
+
+	w := bufio.NewWriter(f)
+	enc := gob.NewEncoder(w)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			f.Close()
+			return f.Name(), err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return f.Name(), err
+	}
+	return f.Name(), f.Close()
+}
+
+// readRun returns an error-aware generator that iterates values spilled into the named file.
+func readRun[V any](name string) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			var zero V
+
+			f, err := os.Open(name)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			defer f.Close()
+
+			dec := gob.NewDecoder(bufio.NewReader(f))
+			for {
+				var v V
+				err := dec.Decode(&v)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// mergeSortedE merges error-aware generators sorted by cmp. It stops at the first error.
+func mergeSortedE[V any](cmp func(V, V) int, gens ...GenE[V]) GenE[V] {
+	return func() iter.Seq2[V, error] {
+		return func(yield func(V, error) bool) {
+			var zero V
+
+			nexts := make([]func() (V, error, bool), len(gens))
+			for i, gen := range gens {
+				next, stop := iter.Pull2(gen())
+				defer stop()
+				nexts[i] = next
+			}
+
+			// ties are broken by index of gens to keep the merge stable
+			h := &valueHeap[Pair[V, int]]{less: func(x, y Pair[V, int]) bool {
+				if c := cmp(x.X, y.X); c != 0 {
+					return c < 0
+				}
+				return x.Y < y.Y
+			}}
+			push := func(i int) bool {
+				v, err, ok := nexts[i]()
+				if !ok {
+					return true
+				}
+				if err != nil {
+					yield(zero, err)
+					return false
+				}
+				heap.Push(h, Pair[V, int]{v, i})
+				return true
+			}
+
+			for i := range nexts {
+				if !push(i) {
+					return
+				}
+			}
+
+			for h.Len() > 0 {
+				p := heap.Pop(h).(Pair[V, int])
+				if !yield(p.X, nil) {
+					return
+				}
+				if !push(p.Y) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// valueHeap implements heap.Interface ordered by less.
+type valueHeap[V any] struct {
+	values []V
+	less   func(V, V) bool
+}
+
+func (h *valueHeap[V]) Len() int           { return len(h.values) }
+func (h *valueHeap[V]) Less(i, j int) bool { return h.less(h.values[i], h.values[j]) }
+func (h *valueHeap[V]) Swap(i, j int)      { h.values[i], h.values[j] = h.values[j], h.values[i] }
+func (h *valueHeap[V]) Push(x any)         { h.values = append(h.values, x.(V)) }
+
+func (h *valueHeap[V]) Pop() any {
+	v := h.values[len(h.values)-1]
+	h.values = h.values[:len(h.values)-1]
+	return v
+}
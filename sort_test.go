@@ -0,0 +1,226 @@
+package itermania
+
+import (
+	"cmp"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[]int{},
+		},
+		{
+			"unsorted",
+			FromSlice([]int{3, 1, 4, 1, 5, 9, 2, 6}),
+			[]int{1, 1, 2, 3, 4, 5, 6, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Sorted(tt.gen, cmp.Compare[int]))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestSortedStable(t *testing.T) {
+	gen := FromSlice([]Pair[int, string]{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	actual := ToSlice(Sorted(gen, func(x, y Pair[int, string]) int { return cmp.Compare(x.X, y.X) }))
+
+	assert.Equal(t, []Pair[int, string]{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}, actual)
+}
+
+func TestTopK(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		k        int
+		expected []int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			3,
+			[]int{},
+		},
+		{
+			"zero",
+			FromSlice([]int{1, 2}),
+			0,
+			[]int{},
+		},
+		{
+			"fewer than k",
+			FromSlice([]int{2, 3, 1}),
+			5,
+			[]int{3, 2, 1},
+		},
+		{
+			"largest k",
+			FromSlice([]int{3, 1, 4, 1, 5, 9, 2, 6}),
+			3,
+			[]int{9, 6, 5},
+		},
+		{
+			"long stream",
+			Head(Mod(Mul(Inc(0), Const(7919)), Const(10007)), 10000),
+			3,
+			[]int{10006, 10005, 10004},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(TopK(tt.gen, tt.k, cmp.Compare[int]))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTopKPanics(t *testing.T) {
+	assert.Panics(t, func() { TopK(Inc(0), -1, cmp.Compare[int]) })
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		gens     []Gen[int]
+		expected []int
+	}{
+		{
+			"no generators",
+			[]Gen[int]{},
+			[]int{},
+		},
+		{
+			"sorted",
+			[]Gen[int]{FromSlice([]int{1, 4, 7}), FromSlice([]int{}), FromSlice([]int{2, 3, 8, 9})},
+			[]int{1, 2, 3, 4, 7, 8, 9},
+		},
+		{
+			"infinite",
+			[]Gen[int]{Head(MergeSorted(cmp.Compare[int], Mul(Inc(0), Const(2)), Mul(Inc(0), Const(3))), 8)},
+			[]int{0, 0, 2, 3, 4, 6, 6, 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(MergeSorted(cmp.Compare[int], tt.gens...))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	byKey := func(x, y Pair[int, string]) int { return cmp.Compare(x.X, y.X) }
+	gen := MergeSorted(byKey,
+		FromSlice([]Pair[int, string]{{1, "a"}, {2, "a"}}),
+		FromSlice([]Pair[int, string]{{1, "b"}, {2, "b"}}),
+	)
+	actual := ToSlice(gen)
+
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, actual)
+}
+
+func TestExternalSorted(t *testing.T) {
+	src := []int{5, 3, 8, 1, 9, 2, 7, 4, 6, 0}
+
+	tests := []struct {
+		name        string
+		maxInMemory int
+		runs        int
+	}{
+		{"in memory", 20, 0},
+		{"exactly in memory", 10, 0},
+		{"spilled", 3, 3},
+		{"one value at a time", 1, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			gen := ExternalSorted(FromSlice(src), cmp.Compare[int], SortOptions{MaxInMemory: tt.maxInMemory, TempDir: dir})
+
+			runs := 0
+			actual := []int{}
+			for v, err := range gen() {
+				assert.NoError(t, err)
+				if len(actual) == 0 {
+					entries, _ := os.ReadDir(dir)
+					runs = len(entries)
+				}
+				actual = append(actual, v)
+			}
+
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+			assert.Equal(t, tt.runs, runs)
+
+			// temporary files are removed
+			entries, err := os.ReadDir(dir)
+			assert.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestExternalSortedStable(t *testing.T) {
+	type record struct {
+		Key   int
+		Value string
+	}
+
+	gen := FromSlice([]record{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}})
+	sorted := ExternalSorted(gen, func(x, y record) int { return cmp.Compare(x.Key, y.Key) }, SortOptions{MaxInMemory: 2, TempDir: t.TempDir()})
+	actual, err := ToSliceE(sorted)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []record{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}, {2, "e"}}, actual)
+}
+
+func TestExternalSortedEarlyExit(t *testing.T) {
+	dir := t.TempDir()
+	gen := ExternalSorted(Head(Dec(100), 10), cmp.Compare[int], SortOptions{MaxInMemory: 2, TempDir: dir})
+
+	for range gen() {
+		break
+	}
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestExternalSortedErrors(t *testing.T) {
+	t.Run("missing temp dir", func(t *testing.T) {
+		dir := t.TempDir() + "/missing"
+		_, err := ToSliceE(ExternalSorted(Range(0, 3, 1), cmp.Compare[int], SortOptions{MaxInMemory: 1, TempDir: dir}))
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("not encodable", func(t *testing.T) {
+		gen := Head(Loop(func() {}), 3)
+		_, err := ToSliceE(ExternalSorted(gen, func(_, _ func()) int { return 0 }, SortOptions{MaxInMemory: 1, TempDir: t.TempDir()}))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		assert.Panics(t, func() { ExternalSorted(Inc(0), cmp.Compare[int], SortOptions{}) })
+	})
+}
@@ -0,0 +1,237 @@
+package itermania
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+)
+
+// Tracer receives events of traced generators.
+//
+// Methods may be called concurrently if generators are iterated concurrently.
+type Tracer interface {
+	// Start is called when an iteration of the generator starts.
+	Start(name string)
+	// Yield is called when the generator yields a value.
+	Yield(name string)
+	// Stop is called when the generator stops by itself.
+	// elapsed is the time spent in producing values, excluding the time of the consumer.
+	Stop(name string, elapsed time.Duration)
+	// EarlyExit is called when the consumer stops the iteration early.
+	// elapsed is the time spent in producing values, excluding the time of the consumer.
+	EarlyExit(name string, elapsed time.Duration)
+}
+
+type tracerHolder struct {
+	tracer Tracer
+}
+
+var currentTracer atomic.Pointer[tracerHolder]
+
+// SetTracer sets the process-wide tracer used by Trace.
+//
+// Built-in combinators created while a tracer is set also report their events.
+// Whether a combinator is traced is decided when it is created, while the tracer receiving
+// its events is looked up on each iteration, as Trace does. So combinators created before SetTracer
+// are never traced, and traced ones stop reporting once nil is set.
+//
+// Built-in combinators are named after themselves and the place they are created, such as "Add at main.go:12",
+// and the right operand of a binary operator is named such as "Add.y at main.go:12".
+// The combinators reporting events are Head, Concat, Alt, Where, Bind, If, LazyIf, Cond, All, Any, Repeat, Limit,
+// Bin, Uni and the operators built on Bin and Uni. Use Trace for other generators.
+//
+// Pass nil to stop tracing.
+func SetTracer(t Tracer) {
+	if t == nil {
+		currentTracer.Store(nil)
+		return
+	}
+	currentTracer.Store(&tracerHolder{tracer: t})
+}
+
+// Trace returns a generator that reports events of gen named name to the tracer set by SetTracer.
+//
+// The tracer is looked up on each iteration, so gen is iterated as is if no tracers are set.
+func Trace[V any](name string, gen Gen[V]) Gen[V] {
+	return func() iter.Seq[V] {
+		holder := currentTracer.Load()
+		if holder == nil {
+			return gen()
+		}
+		return TraceWith(holder.tracer, name, gen)()
+	}
+}
+
+// TraceWith returns a generator that reports events of gen named name to t.
+func TraceWith[V any](t Tracer, name string, gen Gen[V]) Gen[V] {
+	return func() iter.Seq[V] {
+		seq := gen()
+
+		return func(yield func(V) bool) {
+			t.Start(name)
+
+			var elapsed time.Duration
+			start := time.Now()
+			for v := range seq {
+				elapsed += time.Since(start)
+				t.Yield(name)
+
+				if !yield(v) {
+					t.EarlyExit(name, elapsed)
+					return
+				}
+				start = time.Now()
+			}
+
+			elapsed += time.Since(start)
+			t.Stop(name, elapsed)
+		}
+	}
+}
+
+// traced traces gen created by a built-in combinator only if a tracer is set.
+func traced[V any](name string, gen Gen[V]) Gen[V] {
+	return tracedAt(tracePoint(), name, gen)
+}
+
+// tracedAt traces gen named name at point unless point is empty.
+func tracedAt[V any](point, name string, gen Gen[V]) Gen[V] {
+	if point == "" {
+		return gen
+	}
+	return Trace(name+" at "+point, gen)
+}
+
+// tracedFuncAt traces generators returned by f named name at point unless point is empty.
+func tracedFuncAt[V, W any](point, name string, f func(V) Gen[W]) func(V) Gen[W] {
+	if point == "" {
+		return f
+	}
+	return func(v V) Gen[W] {
+		return Trace(name+" at "+point, f(v))
+	}
+}
+
+// packageDir is the directory of this package, used to skip frames of built-in combinators.
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// tracePoint returns the place where a built-in combinator is created, such as "main.go:12",
+// or an empty string if no tracers are set.
+func tracePoint() string {
+	if currentTracer.Load() == nil {
+		return ""
+	}
+
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if filepath.Dir(frame.File) != packageDir || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// Stats is statistics of a traced generator.
+type Stats struct {
+	Name       string
+	Starts     int
+	Yields     int
+	Stops      int
+	EarlyExits int
+	Elapsed    time.Duration
+}
+
+// Collector is a Tracer which collects statistics of generators in memory.
+//
+// Statistics of generators with the same name are summed up.
+type Collector struct {
+	mu    sync.Mutex
+	stats map[string]*Stats
+	// names in the order of the first appearance
+	names []string
+}
+
+// NewCollector returns an empty collector.
+func NewCollector() *Collector {
+	return &Collector{stats: map[string]*Stats{}}
+}
+
+func (c *Collector) Start(name string) {
+	c.update(name, func(s *Stats) { s.Starts++ })
+}
+
+func (c *Collector) Yield(name string) {
+	c.update(name, func(s *Stats) { s.Yields++ })
+}
+
+func (c *Collector) Stop(name string, elapsed time.Duration) {
+	c.update(name, func(s *Stats) {
+		s.Stops++
+		s.Elapsed += elapsed
+	})
+}
+
+func (c *Collector) EarlyExit(name string, elapsed time.Duration) {
+	c.update(name, func(s *Stats) {
+		s.EarlyExits++
+		s.Elapsed += elapsed
+	})
+}
+
+func (c *Collector) update(name string, f func(*Stats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.stats[name]
+	if !ok {
+		s = &Stats{Name: name}
+		c.stats[name] = s
+		c.names = append(c.names, name)
+	}
+	f(s)
+}
+
+// Stats returns the collected statistics in the order of the first appearance.
+func (c *Collector) Stats() []Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := make([]Stats, len(c.names))
+	for i, name := range c.names {
+		stats[i] = *c.stats[name]
+	}
+	return stats
+}
+
+// Reset clears the collected statistics.
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats = map[string]*Stats{}
+	c.names = nil
+}
+
+// Report writes the collected statistics as a text table.
+func (c *Collector) Report(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSTARTS\tYIELDS\tSTOPS\tEARLY EXITS\tELAPSED")
+	for _, s := range c.Stats() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\n", s.Name, s.Starts, s.Yields, s.Stops, s.EarlyExits, s.Elapsed)
+	}
+	return tw.Flush()
+}
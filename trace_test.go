@@ -0,0 +1,236 @@
+package itermania
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is a Tracer which records events.
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) Start(name string) {
+	r.events = append(r.events, "start "+name)
+}
+
+func (r *recorder) Yield(name string) {
+	r.events = append(r.events, "yield "+name)
+}
+
+func (r *recorder) Stop(name string, _ time.Duration) {
+	r.events = append(r.events, "stop "+name)
+}
+
+func (r *recorder) EarlyExit(name string, _ time.Duration) {
+	r.events = append(r.events, "early exit "+name)
+}
+
+// withTracer sets t while the test is running.
+func withTracer(t *testing.T, tracer Tracer) {
+	SetTracer(tracer)
+	t.Cleanup(func() { SetTracer(nil) })
+}
+
+func TestTraceWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		n        int
+		expected []string
+	}{
+		{
+			"stop",
+			Range(0, 2, 1),
+			3,
+			[]string{"start gen", "yield gen", "yield gen", "stop gen"},
+		},
+		{
+			"early exit",
+			Inc(0),
+			2,
+			[]string{"start gen", "yield gen", "yield gen", "early exit gen"},
+		},
+		{
+			"empty",
+			FromSlice([]int{}),
+			3,
+			[]string{"start gen", "stop gen"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			gen := TraceWith(r, "gen", tt.gen)
+
+			for i := range gen() {
+				if i+1 >= tt.n {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.expected, r.events)
+		})
+	}
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("without tracer", func(t *testing.T) {
+		gen := Trace("gen", Range(0, 3, 1))
+		assert.Equal(t, []int{0, 1, 2}, ToSlice(gen))
+	})
+
+	t.Run("with tracer", func(t *testing.T) {
+		// the tracer is looked up on each iteration
+		gen := Trace("gen", Range(0, 2, 1))
+
+		r := &recorder{}
+		withTracer(t, r)
+
+		assert.Equal(t, []int{0, 1}, ToSlice(gen))
+		assert.Equal(t, []string{"start gen", "yield gen", "yield gen", "stop gen"}, r.events)
+	})
+}
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	gen := TraceWith(c, "outer", Bind(Range(0, 3, 1), func(i int) Gen[int] {
+		return TraceWith(c, "inner", Range(0, i, 1))
+	}))
+
+	ToSlice(gen)
+	ToSlice(Head(gen, 1))
+
+	stats := c.Stats()
+	// elapsed time is not deterministic
+	for i := range stats {
+		stats[i].Elapsed = 0
+	}
+
+	assert.Equal(t, []Stats{
+		{Name: "outer", Starts: 2, Yields: 4, Stops: 1, EarlyExits: 1},
+		{Name: "inner", Starts: 5, Yields: 4, Stops: 4, EarlyExits: 1},
+	}, stats)
+
+	c.Reset()
+	assert.Equal(t, []Stats{}, c.Stats())
+}
+
+func TestCollectorReport(t *testing.T) {
+	c := NewCollector()
+	ToSlice(TraceWith(c, "gen", Range(0, 3, 1)))
+	ToSlice(TraceWith(c, "long name", Const(1)))
+
+	out := &bytes.Buffer{}
+	err := c.Report(out)
+
+	assert.NoError(t, err)
+	assert.Regexp(t, fmt.Sprintf("^%s\n%s\n%s\n$",
+		`NAME       STARTS  YIELDS  STOPS  EARLY EXITS  ELAPSED`,
+		`gen        1       3       1      0            \S+s`,
+		`long name  1       1       1      0            \S+s`,
+	), out.String())
+}
+
+// statsOf returns collected statistics keyed by name.
+func statsOf(c *Collector) map[string]Stats {
+	stats := map[string]Stats{}
+	for _, s := range c.Stats() {
+		stats[s.Name] = s
+	}
+	return stats
+}
+
+func TestBuiltinCombinatorsReportToTracer(t *testing.T) {
+	c := NewCollector()
+	withTracer(t, c)
+
+	_, _, line, _ := runtime.Caller(0)
+	gen := Mod(Add(Range(0, 3, 1), Range(0, 2, 1)), Const(2))
+	assert.Equal(t, []int{0, 1, 1, 0, 0, 1}, ToSlice(gen))
+
+	at := fmt.Sprintf(" at trace_test.go:%d", line+1)
+	stats := statsOf(c)
+
+	assert.Equal(t, 1, stats["Add"+at].Starts)
+	assert.Equal(t, 6, stats["Add"+at].Yields)
+	// the right operand is restarted for each value of the left operand
+	assert.Equal(t, 3, stats["Add.y"+at].Starts)
+	assert.Equal(t, 6, stats["Add.y"+at].Yields)
+	assert.Equal(t, 1, stats["Mod"+at].Starts)
+	assert.Equal(t, 6, stats["Mod.y"+at].Starts)
+}
+
+func TestBuiltinCombinatorsNamedByPlace(t *testing.T) {
+	c := NewCollector()
+	withTracer(t, c)
+
+	_, _, line, _ := runtime.Caller(0)
+	inner := func(i int) Gen[int] {
+		return Add(Const(i), Const(1))
+	}
+	gen := Add(Bind(Range(0, 3, 1), inner), Const(10))
+	ToSlice(gen)
+
+	stats := statsOf(c)
+	// instances created at the same place are summed up
+	assert.Equal(t, 3, stats[fmt.Sprintf("Add at trace_test.go:%d", line+2)].Starts)
+	assert.Equal(t, 1, stats[fmt.Sprintf("Add at trace_test.go:%d", line+4)].Starts)
+	assert.Equal(t, 1, stats[fmt.Sprintf("Bind at trace_test.go:%d", line+4)].Starts)
+	assert.Equal(t, 3, stats[fmt.Sprintf("Bind.inner at trace_test.go:%d", line+4)].Starts)
+}
+
+func TestInstrumentedCombinators(t *testing.T) {
+	tests := []struct {
+		name string
+		gen  func() Gen[int]
+	}{
+		{"Head", func() Gen[int] { return Head(Inc(0), 2) }},
+		{"Concat", func() Gen[int] { return Concat(Const(0), Const(1)) }},
+		{"Alt", func() Gen[int] { return Alt(Const(0), Const(1)) }},
+		{"Where", func() Gen[int] { return Where(Const(0), Const(true)) }},
+		{"If", func() Gen[int] { return If(Const(true), Const(0), Const(1)) }},
+		{"LazyIf", func() Gen[int] { return LazyIf(Const(true), Const(0), Const(1)) }},
+		{"Cond", func() Gen[int] { return Cond(Else(Const(0))) }},
+		{"Repeat", func() Gen[int] { return Head(Repeat(Const(0)), 2) }},
+		{"Limit", func() Gen[int] { return Limit(Inc(0), Const(2)) }},
+		{"All", func() Gen[int] { return Where(Const(0), All(Const(true))) }},
+		{"Any", func() Gen[int] { return Where(Const(0), Any(Const(true))) }},
+		{"Not", func() Gen[int] { return Where(Const(0), Not(Const(false))) }},
+		{"Uni", func() Gen[int] { return Uni(func(i int) int { return i })(Const(0)) }},
+		{"Bin", func() Gen[int] { return Bin(func(x, _ int) int { return x })(Const(0), Const(0)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollector()
+			withTracer(t, c)
+
+			ToSlice(tt.gen())
+
+			names := []string{}
+			for _, s := range c.Stats() {
+				names = append(names, strings.SplitN(s.Name, " ", 2)[0])
+			}
+			assert.Contains(t, names, tt.name)
+		})
+	}
+}
+
+func TestBuiltinCombinatorsWithoutTracer(t *testing.T) {
+	c := NewCollector()
+
+	// combinators created before SetTracer are not traced
+	gen := Bind(Range(0, 2, 1), Const)
+	withTracer(t, c)
+
+	assert.Equal(t, []int{0, 1}, ToSlice(gen))
+	assert.Equal(t, []Stats{}, c.Stats())
+}
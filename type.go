@@ -9,6 +9,20 @@ import (
 // Gen is a generator function that generates a new iterator
 type Gen[V any] = func() iter.Seq[V]
 
+// Gen2 is a generator function that generates a new iterator of key-value pairs
+type Gen2[K, V any] = func() iter.Seq2[K, V]
+
+// GenE is a generator function that generates a new iterator which may report an error.
+//
+// An iterator of GenE yields a zero value with a non-nil error at most once as its last element.
+type GenE[V any] = func() iter.Seq2[V, error]
+
 type Number interface {
 	constraints.Integer | constraints.Float
 }
+
+// Pair is a pair of values iterated in lockstep
+type Pair[V, W any] struct {
+	X V
+	Y W
+}
@@ -3,7 +3,7 @@ package itermania
 import "iter"
 
 func Not(gen Gen[bool]) Gen[bool] {
-	uni := Uni(func(v bool) bool {
+	uni := namedUni("Not", func(v bool) bool {
 		return !v
 	})
 	return uni(gen)
@@ -11,8 +11,13 @@ func Not(gen Gen[bool]) Gen[bool] {
 
 // Uni returns a generator from a generators and a unary operation
 func Uni[V, W any](op func(V) W) func(Gen[V]) Gen[W] {
+	return namedUni("Uni", op)
+}
+
+// namedUni works as Uni but is traced as name.
+func namedUni[V, W any](name string, op func(V) W) func(Gen[V]) Gen[W] {
 	return func(xGen Gen[V]) Gen[W] {
-		return func() iter.Seq[W] {
+		return traced(name, func() iter.Seq[W] {
 			return func(yield func(W) bool) {
 				xSeq := xGen()
 				for x := range xSeq {
@@ -21,6 +26,6 @@ func Uni[V, W any](op func(V) W) func(Gen[V]) Gen[W] {
 					}
 				}
 			}
-		}
+		})
 	}
 }
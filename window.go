@@ -0,0 +1,143 @@
+package itermania
+
+import "iter"
+
+// Chunk returns a generator of consecutive chunks of n values in gen.
+//
+// The last chunk may have fewer than n values. It panics if n is not positive.
+func Chunk[V any](gen Gen[V], n int) Gen[[]V] {
+	if n <= 0 {
+		panic("itermania: n of Chunk must be positive")
+	}
+
+	return func() iter.Seq[[]V] {
+		return func(yield func([]V) bool) {
+			seq := gen()
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			for {
+				chunk := make([]V, 0, n)
+				for range n {
+					v, ok := next()
+					if !ok {
+						break
+					}
+					chunk = append(chunk, v)
+				}
+
+				if len(chunk) == 0 {
+					return
+				}
+				if !yield(chunk) {
+					return
+				}
+				if len(chunk) < n {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Window returns a generator of sliding windows of size values in gen, which slide by step values.
+//
+// Windows with fewer than size values are not generated. Values between windows are skipped if step is larger than size.
+// It panics if size or step is not positive.
+func Window[V any](gen Gen[V], size, step int) Gen[[]V] {
+	if size <= 0 || step <= 0 {
+		panic("itermania: size and step of Window must be positive")
+	}
+
+	return func() iter.Seq[[]V] {
+		return func(yield func([]V) bool) {
+			seq := gen()
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			buf := make([]V, 0, size)
+			for {
+				for len(buf) < size {
+					v, ok := next()
+					if !ok {
+						return
+					}
+					buf = append(buf, v)
+				}
+
+				// copy the window so that the consumer can keep it
+				window := make([]V, size)
+				copy(window, buf)
+				if !yield(window) {
+					return
+				}
+
+				if step < size {
+					buf = append(buf[:0], buf[step:]...)
+					continue
+				}
+
+				buf = buf[:0]
+				for range step - size {
+					if _, ok := next(); !ok {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Pairwise returns a generator of pairs of consecutive values in gen.
+func Pairwise[V any](gen Gen[V]) Gen[[2]V] {
+	return func() iter.Seq[[2]V] {
+		return func(yield func([2]V) bool) {
+			seq := gen()
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			prev, ok := next()
+			if !ok {
+				return
+			}
+
+			for {
+				v, ok := next()
+				if !ok {
+					return
+				}
+				if !yield([2]V{prev, v}) {
+					return
+				}
+				prev = v
+			}
+		}
+	}
+}
+
+// Partition splits gen into a generator of values satisfying pred and a generator of the others.
+//
+// Each of the results iterates gen independently.
+func Partition[V any](gen Gen[V], pred func(V) bool) (Gen[V], Gen[V]) {
+	matched := func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				if pred(v) && !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	unmatched := func() iter.Seq[V] {
+		return func(yield func(V) bool) {
+			for v := range gen() {
+				if !pred(v) && !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	return matched, unmatched
+}
@@ -0,0 +1,173 @@
+package itermania
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		n        int
+		expected [][]int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			2,
+			[][]int{},
+		},
+		{
+			"divisible",
+			Range(0, 4, 1),
+			2,
+			[][]int{{0, 1}, {2, 3}},
+		},
+		{
+			"last chunk is shorter",
+			Range(0, 5, 1),
+			2,
+			[][]int{{0, 1}, {2, 3}, {4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Chunk(tt.gen, tt.n))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestChunkInfinite(t *testing.T) {
+	actual := ToSlice(Head(Chunk(Inc(0), 3), 2))
+
+	assert.Equal(t, [][]int{{0, 1, 2}, {3, 4, 5}}, actual)
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			2,
+			1,
+			[][]int{},
+		},
+		{
+			"shorter than size",
+			Range(0, 2, 1),
+			3,
+			1,
+			[][]int{},
+		},
+		{
+			"sliding by one",
+			Range(0, 5, 1),
+			3,
+			1,
+			[][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			"sliding by two",
+			Range(0, 6, 1),
+			3,
+			2,
+			[][]int{{0, 1, 2}, {2, 3, 4}},
+		},
+		{
+			"tumbling",
+			Range(0, 7, 1),
+			3,
+			3,
+			[][]int{{0, 1, 2}, {3, 4, 5}},
+		},
+		{
+			"step is larger than size",
+			Range(0, 10, 1),
+			2,
+			4,
+			[][]int{{0, 1}, {4, 5}, {8, 9}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Window(tt.gen, tt.size, tt.step))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestWindowInfinite(t *testing.T) {
+	actual := ToSlice(Head(Window(Inc(0), 2, 1), 3))
+
+	assert.Equal(t, [][]int{{0, 1}, {1, 2}, {2, 3}}, actual)
+}
+
+func TestInvalidSizes(t *testing.T) {
+	assert.Panics(t, func() { Chunk(Inc(0), 0) })
+	assert.Panics(t, func() { Window(Inc(0), 0, 1) })
+	assert.Panics(t, func() { Window(Inc(0), 1, 0) })
+}
+
+func TestPairwise(t *testing.T) {
+	tests := []struct {
+		name     string
+		gen      Gen[int]
+		expected [][2]int
+	}{
+		{
+			"empty",
+			FromSlice([]int{}),
+			[][2]int{},
+		},
+		{
+			"one value",
+			Const(1),
+			[][2]int{},
+		},
+		{
+			"multiple values",
+			Range(0, 4, 1),
+			[][2]int{{0, 1}, {1, 2}, {2, 3}},
+		},
+		{
+			"infinite",
+			Head(Inc(0), 4),
+			[][2]int{{0, 1}, {1, 2}, {2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ToSlice(Pairwise(tt.gen))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even, odd := Partition(Range(0, 7, 1), func(v int) bool { return v%2 == 0 })
+
+	assert.Equal(t, []int{0, 2, 4, 6}, ToSlice(even))
+	assert.Equal(t, []int{1, 3, 5}, ToSlice(odd))
+}
+
+func TestPartitionInfinite(t *testing.T) {
+	even, odd := Partition(Inc(0), func(v int) bool { return v%2 == 0 })
+
+	assert.Equal(t, []int{0, 2, 4}, ToSlice(Head(even, 3)))
+	assert.Equal(t, []int{1, 3, 5}, ToSlice(Head(odd, 3)))
+}
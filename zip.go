@@ -0,0 +1,142 @@
+package itermania
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrLengthMismatch is a panic value of strict zip operations when the lengths of generators differ.
+var ErrLengthMismatch = errors.New("itermania: generators have different lengths")
+
+// Zip returns a generator of pairs iterated from two generators in lockstep.
+//
+// It stops when either of the generators stops.
+func Zip[V, W any](xGen Gen[V], yGen Gen[W]) Gen[Pair[V, W]] {
+	return zip(func(x V, y W) Pair[V, W] {
+		return Pair[V, W]{X: x, Y: y}
+	}, xGen, yGen)
+}
+
+// ZipWith returns a generator from two generators and a binary operation.
+// Unlike Bin, values of the generators are iterated in lockstep.
+//
+// The result stops when either of the generators stops.
+func ZipWith[V, W any](op func(V, V) W) func(Gen[V], Gen[V]) Gen[W] {
+	return func(xGen Gen[V], yGen Gen[V]) Gen[W] {
+		return zip(op, xGen, yGen)
+	}
+}
+
+// ZipLongestWith works as ZipWith but the result stops when both of the generators stop.
+//
+// The generator stopped earlier is filled with xFill or yFill respectively.
+func ZipLongestWith[V, W any](op func(V, V) W, xFill V, yFill V) func(Gen[V], Gen[V]) Gen[W] {
+	return func(xGen Gen[V], yGen Gen[V]) Gen[W] {
+		return func() iter.Seq[W] {
+			return func(yield func(W) bool) {
+				xNext, xStop := iter.Pull(xGen())
+				defer xStop()
+				yNext, yStop := iter.Pull(yGen())
+				defer yStop()
+
+				for {
+					x, xOk := xNext()
+					y, yOk := yNext()
+					if !xOk && !yOk {
+						return
+					}
+
+					if !xOk {
+						x = xFill
+					}
+					if !yOk {
+						y = yFill
+					}
+
+					if !yield(op(x, y)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// ZipStrictWith works as ZipWith but the result panics with ErrLengthMismatch
+// if one of the generators stops earlier than the other.
+//
+// NOTE: the panic is not recovered in goroutines of ParBind or Merge (use ZipStrictWithE to handle the mismatch as an error)
+func ZipStrictWith[V, W any](op func(V, V) W) func(Gen[V], Gen[V]) Gen[W] {
+	zipWith := ZipStrictWithE(op)
+	return func(xGen Gen[V], yGen Gen[V]) Gen[W] {
+		gen := zipWith(xGen, yGen)
+		return func() iter.Seq[W] {
+			return func(yield func(W) bool) {
+				for w, err := range gen() {
+					if err != nil {
+						panic(err)
+					}
+
+					if !yield(w) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// ZipStrictWithE works as ZipWith but the result reports ErrLengthMismatch
+// if one of the generators stops earlier than the other.
+func ZipStrictWithE[V, W any](op func(V, V) W) func(Gen[V], Gen[V]) GenE[W] {
+	return func(xGen Gen[V], yGen Gen[V]) GenE[W] {
+		return func() iter.Seq2[W, error] {
+			return func(yield func(W, error) bool) {
+				xNext, xStop := iter.Pull(xGen())
+				defer xStop()
+				yNext, yStop := iter.Pull(yGen())
+				defer yStop()
+
+				for {
+					x, xOk := xNext()
+					y, yOk := yNext()
+					if xOk != yOk {
+						var zero W
+						yield(zero, ErrLengthMismatch)
+						return
+					}
+					if !xOk {
+						return
+					}
+
+					if !yield(op(x, y), nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func zip[V, W, X any](op func(V, W) X, xGen Gen[V], yGen Gen[W]) Gen[X] {
+	return func() iter.Seq[X] {
+		return func(yield func(X) bool) {
+			xSeq := xGen()
+
+			ySeq := yGen()
+			yNext, yStop := iter.Pull(ySeq)
+			defer yStop()
+
+			for x := range xSeq {
+				y, ok := yNext()
+				if !ok {
+					return
+				}
+
+				if !yield(op(x, y)) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Package zip provides binary operators which iterate their operands in lockstep.
+//
+// While operators in itermania take the cartesian product of their operands,
+// operators in this package combine the i-th values of both operands, just like itermania.Where and itermania.If.
+// The result stops when either of the operands stops.
+// Use itermania.ZipLongestWith, itermania.ZipStrictWith or itermania.ZipStrictWithE for the other behaviors on unequal lengths.
+package zip
+
+import (
+	"github.com/syuparn/itermania"
+	"golang.org/x/exp/constraints"
+)
+
+func And[V bool](xGen itermania.Gen[bool], yGen itermania.Gen[bool]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal bool, yVal bool) bool {
+		return xVal && yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Or[V bool](xGen itermania.Gen[bool], yGen itermania.Gen[bool]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal bool, yVal bool) bool {
+		return xVal || yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Eq[V comparable](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal == yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Neq[V comparable](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal != yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Gt[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal > yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Lt[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal < yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Ge[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal >= yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Le[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[bool] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) bool {
+		return xVal <= yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Add[V constraints.Ordered](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) V {
+		return xVal + yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Sub[V itermania.Number](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) V {
+		return xVal - yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Mul[V itermania.Number](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) V {
+		return xVal * yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Div[V itermania.Number](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) V {
+		return xVal / yVal
+	})
+	return zipWith(xGen, yGen)
+}
+
+func Mod[V constraints.Integer](xGen itermania.Gen[V], yGen itermania.Gen[V]) itermania.Gen[V] {
+	zipWith := itermania.ZipWith(func(xVal V, yVal V) V {
+		return xVal % yVal
+	})
+	return zipWith(xGen, yGen)
+}
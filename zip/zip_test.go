@@ -0,0 +1,87 @@
+package zip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syuparn/itermania"
+)
+
+func TestBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(itermania.Gen[bool], itermania.Gen[bool]) itermania.Gen[bool]
+		expected []bool
+	}{
+		{"And", And[bool], []bool{true, false, false, false}},
+		{"Or", Or[bool], []bool{true, true, true, false}},
+	}
+
+	x := itermania.FromSlice([]bool{true, true, false, false})
+	y := itermania.FromSlice([]bool{true, false, true, false})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(tt.op(x, y))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(itermania.Gen[int], itermania.Gen[int]) itermania.Gen[bool]
+		expected []bool
+	}{
+		{"Eq", Eq[int], []bool{false, true, false}},
+		{"Neq", Neq[int], []bool{true, false, true}},
+		{"Gt", Gt[int], []bool{false, false, true}},
+		{"Lt", Lt[int], []bool{true, false, false}},
+		{"Ge", Ge[int], []bool{false, true, true}},
+		{"Le", Le[int], []bool{true, true, false}},
+	}
+
+	x := itermania.FromSlice([]int{1, 2, 3})
+	y := itermania.FromSlice([]int{2, 2, 2})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(tt.op(x, y))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(itermania.Gen[int], itermania.Gen[int]) itermania.Gen[int]
+		expected []int
+	}{
+		{"Add", Add[int], []int{9, 12, 15}},
+		{"Sub", Sub[int], []int{5, 6, 7}},
+		{"Mul", Mul[int], []int{14, 27, 44}},
+		{"Div", Div[int], []int{3, 3, 2}},
+		{"Mod", Mod[int], []int{1, 0, 3}},
+	}
+
+	x := itermania.FromSlice([]int{7, 9, 11})
+	y := itermania.FromSlice([]int{2, 3, 4})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := itermania.ToSlice(tt.op(x, y))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestUnequalLengths(t *testing.T) {
+	actual := itermania.ToSlice(Add(itermania.Inc(0), itermania.FromSlice([]int{10, 20})))
+
+	assert.Equal(t, []int{10, 21}, actual)
+}
@@ -0,0 +1,193 @@
+package itermania
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        Gen[int]
+		y        Gen[string]
+		expected []Pair[int, string]
+	}{
+		{
+			"same length",
+			Range(1, 3, 1),
+			FromSlice([]string{"a", "b"}),
+			[]Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			"x is shorter",
+			Const(1),
+			FromSlice([]string{"a", "b"}),
+			[]Pair[int, string]{{1, "a"}},
+		},
+		{
+			"y is shorter",
+			Inc(1),
+			FromSlice([]string{"a", "b"}),
+			[]Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := Zip(tt.x, tt.y)
+			actual := ToSlice(gen)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        Gen[int]
+		y        Gen[int]
+		expected []int
+	}{
+		{
+			"same length",
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{10, 20}),
+			[]int{11, 22},
+		},
+		{
+			"x is shorter",
+			Const(1),
+			FromSlice([]int{10, 20}),
+			[]int{11},
+		},
+		{
+			"y is shorter",
+			Inc(1),
+			FromSlice([]int{10, 20}),
+			[]int{11, 22},
+		},
+		{
+			"empty",
+			FromSlice([]int{}),
+			Inc(1),
+			[]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zipWith := ZipWith(func(x int, y int) int { return x + y })
+			actual := ToSlice(zipWith(tt.x, tt.y))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestZipLongestWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        Gen[int]
+		y        Gen[int]
+		expected []int
+	}{
+		{
+			"same length",
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{10, 20}),
+			[]int{11, 22},
+		},
+		{
+			"x is shorter",
+			Const(1),
+			FromSlice([]int{10, 20}),
+			[]int{11, 120},
+		},
+		{
+			"y is shorter",
+			FromSlice([]int{1, 2}),
+			Const(10),
+			[]int{11, 1002},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zipWith := ZipLongestWith(func(x int, y int) int { return x + y }, 100, 1000)
+			actual := ToSlice(zipWith(tt.x, tt.y))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestZipStrictWith(t *testing.T) {
+	zipWith := ZipStrictWith(func(x int, y int) int { return x + y })
+
+	t.Run("same length", func(t *testing.T) {
+		actual := ToSlice(zipWith(FromSlice([]int{1, 2}), FromSlice([]int{10, 20})))
+		assert.Equal(t, []int{11, 22}, actual)
+	})
+
+	t.Run("x is shorter", func(t *testing.T) {
+		assert.PanicsWithValue(t, ErrLengthMismatch, func() {
+			ToSlice(zipWith(Const(1), FromSlice([]int{10, 20})))
+		})
+	})
+
+	t.Run("y is shorter", func(t *testing.T) {
+		assert.PanicsWithValue(t, ErrLengthMismatch, func() {
+			ToSlice(zipWith(FromSlice([]int{1, 2}), Const(10)))
+		})
+	})
+
+	t.Run("early exit does not panic", func(t *testing.T) {
+		actual := ToSlice(Head(zipWith(Inc(1), Const(10)), 1))
+		assert.Equal(t, []int{11}, actual)
+	})
+}
+
+func TestZipStrictWithE(t *testing.T) {
+	zipWith := ZipStrictWithE(func(x int, y int) int { return x + y })
+
+	tests := []struct {
+		name     string
+		xGen     Gen[int]
+		yGen     Gen[int]
+		expected []int
+		err      error
+	}{
+		{
+			"same length",
+			FromSlice([]int{1, 2}),
+			FromSlice([]int{10, 20}),
+			[]int{11, 22},
+			nil,
+		},
+		{
+			"x is shorter",
+			Const(1),
+			FromSlice([]int{10, 20}),
+			[]int{11},
+			ErrLengthMismatch,
+		},
+		{
+			"y is shorter",
+			FromSlice([]int{1, 2}),
+			Const(10),
+			[]int{11},
+			ErrLengthMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ToSliceE(zipWith(tt.xGen, tt.yGen))
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}